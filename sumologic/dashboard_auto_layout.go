@@ -0,0 +1,200 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Width of the dashboard grid in layout units.
+const dashboardGridWidth = 24
+
+func getAutoLayoutSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"columns": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      2,
+			ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 6, 8, 12, 24}),
+		},
+		"panel_height": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      8,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"panel_widths": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeInt,
+			},
+		},
+	}
+}
+
+type gridLayoutStructure struct {
+	Height int `json:"height"`
+	Width  int `json:"width"`
+	X      int `json:"x"`
+	Y      int `json:"y"`
+}
+
+// getAutoLayout places the panels left to right in rows of the configured number of columns, in the
+// order of panelKeys. A panel spans the number of columns given in panel_widths, or one column, and
+// starts a new row if it doesn't fit in the current one.
+func getAutoLayout(panelKeys []string, tfAutoLayout map[string]interface{}) GridLayout {
+	columns := tfAutoLayout["columns"].(int)
+	height := tfAutoLayout["panel_height"].(int)
+	widths, _ := tfAutoLayout["panel_widths"].(map[string]interface{})
+	columnWidth := dashboardGridWidth / columns
+
+	structures := make([]LayoutStructure, 0, len(panelKeys))
+	x, y := 0, 0
+	for _, key := range panelKeys {
+		span := 1
+		if val, ok := widths[key].(int); ok && val > 0 {
+			span = val
+		}
+		if span > columns {
+			span = columns
+		}
+		width := span * columnWidth
+		if x+width > dashboardGridWidth {
+			x, y = 0, y+height
+		}
+
+		structure, _ := json.Marshal(gridLayoutStructure{Height: height, Width: width, X: x, Y: y})
+		structures = append(structures, LayoutStructure{
+			Key:       key,
+			Structure: string(structure),
+		})
+		x += width
+	}
+
+	return GridLayout{
+		LayoutType:       "Grid",
+		LayoutStructures: structures,
+	}
+}
+
+// isEquivalentGridLayout returns whether the layout returned by the API places every panel at the same
+// position and size as expected, ignoring the order of the structures and any other settings.
+func isEquivalentGridLayout(layout map[string]interface{}, expected GridLayout) bool {
+	if layout["layoutType"] != expected.LayoutType {
+		return false
+	}
+	layoutStructures, _ := layout["layoutStructures"].([]interface{})
+	if len(layoutStructures) != len(expected.LayoutStructures) {
+		return false
+	}
+
+	positions := make(map[string]gridLayoutStructure, len(layoutStructures))
+	for _, val := range layoutStructures {
+		layoutStructure, ok := val.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		key, _ := layoutStructure["key"].(string)
+		structure, _ := layoutStructure["structure"].(string)
+		var position gridLayoutStructure
+		if err := json.Unmarshal([]byte(structure), &position); err != nil {
+			return false
+		}
+		positions[key] = position
+	}
+
+	for _, expectedStructure := range expected.LayoutStructures {
+		var expectedPosition gridLayoutStructure
+		if err := json.Unmarshal([]byte(expectedStructure.Structure), &expectedPosition); err != nil {
+			return false
+		}
+		if position, ok := positions[expectedStructure.Key]; !ok || position != expectedPosition {
+			return false
+		}
+	}
+	return true
+}
+
+// validateAutoLayout checks that panel_widths only refers to panels of the dashboard and that no panel
+// is wider than the grid.
+func validateAutoLayout(panelKeys []string, tfAutoLayout map[string]interface{}) error {
+	columns := tfAutoLayout["columns"].(int)
+	widths, _ := tfAutoLayout["panel_widths"].(map[string]interface{})
+	// Keys that are not known yet are empty, in which case panel_widths can't be checked against them.
+	keysKnown := !contains(panelKeys, "")
+	for key, val := range widths {
+		if keysKnown && !contains(panelKeys, key) {
+			return fmt.Errorf("auto_layout.panel_widths refers to panel %s, which is not a panel of the dashboard", key)
+		}
+		if width, ok := val.(int); ok && (width < 1 || width > columns) {
+			return fmt.Errorf("auto_layout.panel_widths of panel %s must be between 1 and columns (%d), got %d",
+				key, columns, width)
+		}
+	}
+	return nil
+}
+
+// getPanelKey returns the key of a panel, either converted from its block or as returned by the API.
+func getPanelKey(panel interface{}) string {
+	switch p := panel.(type) {
+	case TextPanel:
+		return p.Key
+	case SumoSearchPanel:
+		return p.Key
+	case TracesListPanel:
+		return p.Key
+	case ServiceMapPanel:
+		return p.Key
+	case QueryPanel:
+		return p.Key
+	case LinksPanel:
+		return p.Key
+	case map[string]interface{}:
+		key, _ := p["key"].(string)
+		return key
+	}
+	return ""
+}
+
+// getTerraformPanelKeys returns the keys of the panels defined by panel blocks, in order.
+func getTerraformPanelKeys(tfPanels []interface{}) []string {
+	var keys []string
+	for _, val := range tfPanels {
+		tfPanel, _ := val.(map[string]interface{})
+		for _, tfBlock := range tfPanel {
+			if list, ok := tfBlock.([]interface{}); ok && len(list) == 1 {
+				if tfPanelBlock, ok := list[0].(map[string]interface{}); ok {
+					key, _ := tfPanelBlock["key"].(string)
+					keys = append(keys, key)
+					break
+				}
+			}
+		}
+	}
+	return keys
+}
+
+// getAutoLayoutConfig returns the auto_layout block, if any, with the defaults of an empty block.
+func getAutoLayoutConfig(tfAutoLayout []interface{}) (map[string]interface{}, bool) {
+	if len(tfAutoLayout) == 0 {
+		return nil, false
+	}
+	if config, ok := tfAutoLayout[0].(map[string]interface{}); ok {
+		return config, true
+	}
+	return map[string]interface{}{
+		"columns":      getAutoLayoutSchema()["columns"].Default,
+		"panel_height": getAutoLayoutSchema()["panel_height"].Default,
+	}, true
+}
+
+func getPanelKeys(panels []interface{}) []string {
+	keys := make([]string, len(panels))
+	for i, panel := range panels {
+		keys[i] = getPanelKey(panel)
+	}
+	return keys
+}
@@ -0,0 +1,73 @@
+package sumologic
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGetAutoLayout(t *testing.T) {
+	tfAutoLayout := map[string]interface{}{
+		"columns":      3,
+		"panel_height": 6,
+		"panel_widths": map[string]interface{}{"wide": 2, "full": 3},
+	}
+	layout := getAutoLayout([]string{"a", "wide", "b", "c", "full", "d"}, tfAutoLayout)
+
+	expected := map[string]string{
+		"a":    `{"height":6,"width":8,"x":0,"y":0}`,
+		"wide": `{"height":6,"width":16,"x":8,"y":0}`,
+		"b":    `{"height":6,"width":8,"x":0,"y":6}`,
+		"c":    `{"height":6,"width":8,"x":8,"y":6}`,
+		"full": `{"height":6,"width":24,"x":0,"y":12}`,
+		"d":    `{"height":6,"width":8,"x":0,"y":18}`,
+	}
+	if layout.LayoutType != "Grid" || len(layout.LayoutStructures) != len(expected) {
+		t.Fatalf("unexpected layout %+v", layout)
+	}
+	for _, structure := range layout.LayoutStructures {
+		if structure.Structure != expected[structure.Key] {
+			t.Errorf("expected structure of panel %s to be %s, got %s", structure.Key, expected[structure.Key], structure.Structure)
+		}
+	}
+}
+
+func TestIsEquivalentGridLayout(t *testing.T) {
+	expected := getAutoLayout([]string{"a", "b"}, map[string]interface{}{"columns": 2, "panel_height": 8})
+
+	// The API may return the structures in another order, with other settings and formatting.
+	layout := map[string]interface{}{
+		"layoutType": "Grid",
+		"layoutStructures": []interface{}{
+			map[string]interface{}{"key": "b", "structure": `{"x":12,"y":0,"width":12,"height":8,"minHeight":4}`},
+			map[string]interface{}{"key": "a", "structure": `{"height":8,"width":12,"x":0,"y":0}`},
+		},
+	}
+	if !isEquivalentGridLayout(layout, expected) {
+		t.Errorf("expected layout to be equivalent")
+	}
+
+	layout["layoutStructures"].([]interface{})[0].(map[string]interface{})["structure"] = `{"height":8,"width":12,"x":0,"y":8}`
+	if isEquivalentGridLayout(layout, expected) {
+		t.Errorf("expected moved panel not to be equivalent")
+	}
+	layout["layoutStructures"] = layout["layoutStructures"].([]interface{})[1:]
+	if isEquivalentGridLayout(layout, expected) {
+		t.Errorf("expected layout with a missing panel not to be equivalent")
+	}
+}
+
+func TestValidateAutoLayout(t *testing.T) {
+	panelKeys := []string{"a", "b"}
+	testCases := map[string]map[string]interface{}{
+		"which is not a panel of the dashboard": {"columns": 2, "panel_widths": map[string]interface{}{"c": 1}},
+		"must be between 1 and columns (2)":     {"columns": 2, "panel_widths": map[string]interface{}{"a": 3}},
+	}
+	for expectedError, tfAutoLayout := range testCases {
+		if err := validateAutoLayout(panelKeys, tfAutoLayout); err == nil || !strings.Contains(err.Error(), expectedError) {
+			t.Errorf("expected error to contain %q, got %v", expectedError, err)
+		}
+	}
+	if err := validateAutoLayout(panelKeys, map[string]interface{}{"columns": 2, "panel_widths": map[string]interface{}{"b": 2}}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
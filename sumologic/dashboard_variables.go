@@ -0,0 +1,198 @@
+package sumologic
+
+import (
+	"encoding/csv"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// dashboardVariablePlaceholderRegex matches the {{name}} placeholders of variables in panel queries.
+var dashboardVariablePlaceholderRegex = regexp.MustCompile(`\{\{\s*([^{}\s]+)\s*\}\}`)
+
+// validateDashboardVariables checks that the queries of the panels only reference declared variables
+// and that the values of CSV variables parse. Values that are unchanged from previousVariables are not
+// checked, so that existing dashboards with such values keep planning.
+func validateDashboardVariables(tfPanels []interface{}, tfVariables []interface{}, previousVariables []interface{}) error {
+	previousCsvValues := make(map[string]string)
+	for _, val := range previousVariables {
+		if tfVariable, ok := val.(map[string]interface{}); ok {
+			if values, ok := getCsvVariableValues(tfVariable["source_definition"].([]interface{})); ok {
+				previousCsvValues[tfVariable["name"].(string)] = values
+			}
+		}
+	}
+
+	declared := make(map[string]bool)
+	var names []string
+	for _, val := range tfVariables {
+		tfVariable, ok := val.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name := tfVariable["name"].(string)
+		if name == "" {
+			// The name is not known yet, so references can't be checked.
+			return nil
+		}
+		// Variable names are case-insensitive.
+		declared[strings.ToLower(name)] = true
+		names = append(names, name)
+
+		previousValues, hasPreviousValues := previousCsvValues[name]
+		if err := validateVariableSourceDefinition(name, tfVariable["source_definition"].([]interface{}),
+			previousValues, hasPreviousValues); err != nil {
+			return err
+		}
+	}
+	sort.Strings(names)
+
+	for _, val := range tfPanels {
+		tfPanel, _ := val.(map[string]interface{})
+		for _, tfBlock := range tfPanel {
+			list, ok := tfBlock.([]interface{})
+			if !ok || len(list) != 1 {
+				continue
+			}
+			tfPanelBlock, ok := list[0].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, queriesKey := range []string{"query", "queries"} {
+				tfQueries, _ := tfPanelBlock[queriesKey].([]interface{})
+				for _, tfQuery := range tfQueries {
+					query, _ := tfQuery.(map[string]interface{})
+					queryString, _ := query["query_string"].(string)
+					for _, match := range dashboardVariablePlaceholderRegex.FindAllStringSubmatch(queryString, -1) {
+						if !declared[strings.ToLower(match[1])] {
+							return fmt.Errorf("query %v of panel %v references undeclared variable %s, declared variables are: [%s]",
+								query["query_key"], tfPanelBlock["key"], match[1], strings.Join(names, ", "))
+						}
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func validateVariableSourceDefinition(name string, tfSourceDefinition []interface{}, previousValues string, hasPreviousValues bool) error {
+	if len(tfSourceDefinition) != 1 || tfSourceDefinition[0] == nil {
+		return nil
+	}
+	sourceDefinition := tfSourceDefinition[0].(map[string]interface{})
+
+	var kinds []string
+	for kind, val := range sourceDefinition {
+		if list, ok := val.([]interface{}); ok && len(list) > 0 {
+			kinds = append(kinds, kind)
+		}
+	}
+	if len(kinds) != 1 {
+		return fmt.Errorf("source_definition of variable %s must contain exactly one of %s",
+			name, strings.Join(variableSourceDefinitionKinds(), ", "))
+	}
+
+	if values, ok := getCsvVariableValues(tfSourceDefinition); ok && (!hasPreviousValues || values != previousValues) {
+		if _, err := parseCsvVariableValues(values); err != nil {
+			return fmt.Errorf("invalid values of variable %s: %s", name, err)
+		}
+	}
+	return nil
+}
+
+// getCsvVariableValues returns the values of a variable with a csv_variable_source_definition.
+func getCsvVariableValues(tfSourceDefinition []interface{}) (string, bool) {
+	if len(tfSourceDefinition) != 1 || tfSourceDefinition[0] == nil {
+		return "", false
+	}
+	csvDefinition, _ := tfSourceDefinition[0].(map[string]interface{})["csv_variable_source_definition"].([]interface{})
+	if len(csvDefinition) != 1 || csvDefinition[0] == nil {
+		return "", false
+	}
+	return csvDefinition[0].(map[string]interface{})["values"].(string), true
+}
+
+// parseCsvVariableValues parses the comma separated values of a CSV variable, which may be quoted.
+func parseCsvVariableValues(values string) ([]string, error) {
+	if values == "" {
+		// The values are not known yet.
+		return nil, nil
+	}
+	reader := csv.NewReader(strings.NewReader(values))
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) != 1 {
+		return nil, fmt.Errorf("values must be a single line of comma separated values")
+	}
+
+	seen := make(map[string]bool)
+	for _, value := range records[0] {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			return nil, fmt.Errorf("values must not be empty")
+		}
+		if seen[value] {
+			return nil, fmt.Errorf("value %s is specified more than once", value)
+		}
+		seen[value] = true
+	}
+	return records[0], nil
+}
+
+func variableSourceDefinitionKinds() []string {
+	var kinds []string
+	for kind := range getSourceDefinitionSchema() {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// validateLookupTableVariables checks that the lookup tables referenced by variables exist and contain
+// the referenced field. References to lookup tables whose id is not known yet are skipped.
+func validateLookupTableVariables(c *Client, tfVariables []interface{}) error {
+	for _, val := range tfVariables {
+		tfVariable, ok := val.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		tfSourceDefinition, _ := tfVariable["source_definition"].([]interface{})
+		if len(tfSourceDefinition) != 1 || tfSourceDefinition[0] == nil {
+			continue
+		}
+		tfLookupDefinition, _ := tfSourceDefinition[0].(map[string]interface{})["lookup_table_variable_source_definition"].([]interface{})
+		if len(tfLookupDefinition) != 1 || tfLookupDefinition[0] == nil {
+			continue
+		}
+		lookupDefinition := tfLookupDefinition[0].(map[string]interface{})
+		lookupTableId := lookupDefinition["lookup_table_id"].(string)
+		field := lookupDefinition["field"].(string)
+		if lookupTableId == "" {
+			continue
+		}
+
+		lookupTable, err := c.GetLookupTable(lookupTableId)
+		if err != nil {
+			return err
+		}
+		if lookupTable == nil {
+			return fmt.Errorf("lookup table %s of variable %s does not exist", lookupTableId, tfVariable["name"])
+		}
+		if field == "" {
+			continue
+		}
+		var fieldNames []string
+		for _, lookupTableField := range lookupTable.Fields {
+			fieldNames = append(fieldNames, lookupTableField.FieldName)
+		}
+		if !contains(fieldNames, field) {
+			return fmt.Errorf("lookup table %s of variable %s has no field %s, its fields are: [%s]",
+				lookupTableId, tfVariable["name"], field, strings.Join(fieldNames, ", "))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package sumologic
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func exampleTerraformVariable(name string, sourceDefinition map[string]interface{}) map[string]interface{} {
+	tfSourceDefinition := map[string]interface{}{
+		"metadata_variable_source_definition":     []interface{}{},
+		"log_query_variable_source_definition":    []interface{}{},
+		"csv_variable_source_definition":          []interface{}{},
+		"lookup_table_variable_source_definition": []interface{}{},
+	}
+	for kind, val := range sourceDefinition {
+		tfSourceDefinition[kind] = []interface{}{val}
+	}
+	return map[string]interface{}{
+		"name":              name,
+		"source_definition": []interface{}{tfSourceDefinition},
+	}
+}
+
+func exampleTerraformSearchPanel(queryString string) map[string]interface{} {
+	return map[string]interface{}{
+		"sumo_search_panel": []interface{}{
+			map[string]interface{}{
+				"key": "search-panel-01",
+				"query": []interface{}{
+					map[string]interface{}{"query_key": "A", "query_string": queryString},
+				},
+			},
+		},
+		"text_panel": []interface{}{},
+	}
+}
+
+func TestValidateDashboardVariables(t *testing.T) {
+	variables := []interface{}{
+		exampleTerraformVariable("env", map[string]interface{}{
+			"csv_variable_source_definition": map[string]interface{}{"values": `prod,dev,"a,b"`},
+		}),
+		exampleTerraformVariable("region", map[string]interface{}{
+			"lookup_table_variable_source_definition": map[string]interface{}{
+				"lookup_table_id": "0000000000000001",
+				"field":           "region",
+			},
+		}),
+	}
+
+	for _, queryString := range []string{
+		"_sourceCategory={{env}} | count",
+		"_sourceCategory={{ Env }}/{{region}} | count",
+		"_sourceCategory=api | count",
+	} {
+		panels := []interface{}{exampleTerraformSearchPanel(queryString)}
+		if err := validateDashboardVariables(panels, variables, nil); err != nil {
+			t.Errorf("unexpected error for %s: %v", queryString, err)
+		}
+	}
+
+	panels := []interface{}{exampleTerraformSearchPanel("_sourceCategory={{enviroment}} | count")}
+	err := validateDashboardVariables(panels, variables, nil)
+	if err == nil || !strings.Contains(err.Error(), "references undeclared variable enviroment") {
+		t.Errorf("expected undeclared variable error, got %v", err)
+	}
+
+	// Variables whose names are not known yet can't be checked.
+	unknown := append(variables, exampleTerraformVariable("", map[string]interface{}{
+		"csv_variable_source_definition": map[string]interface{}{"values": "a"},
+	}))
+	if err := validateDashboardVariables(panels, unknown, nil); err != nil {
+		t.Errorf("unexpected error with unknown variable name: %v", err)
+	}
+}
+
+func TestValidateDashboardVariables_sourceDefinition(t *testing.T) {
+	testCases := map[string]map[string]interface{}{
+		"values must not be empty": {
+			"csv_variable_source_definition": map[string]interface{}{"values": "prod,,dev"},
+		},
+		"value prod is specified more than once": {
+			"csv_variable_source_definition": map[string]interface{}{"values": "prod,dev,prod"},
+		},
+		"single line": {
+			"csv_variable_source_definition": map[string]interface{}{"values": "prod\ndev"},
+		},
+		"must contain exactly one of": {
+			"csv_variable_source_definition":      map[string]interface{}{"values": "prod"},
+			"metadata_variable_source_definition": map[string]interface{}{"filter": "", "key": "_sourceCategory"},
+		},
+	}
+	for expectedError, sourceDefinition := range testCases {
+		variables := []interface{}{exampleTerraformVariable("env", sourceDefinition)}
+		err := validateDashboardVariables([]interface{}{}, variables, nil)
+		if err == nil || !strings.Contains(err.Error(), expectedError) {
+			t.Errorf("expected error to contain %q, got %v", expectedError, err)
+		}
+	}
+}
+
+func TestValidateDashboardVariables_unchangedCsvValues(t *testing.T) {
+	previous := []interface{}{exampleTerraformVariable("env", map[string]interface{}{
+		"csv_variable_source_definition": map[string]interface{}{"values": "prod,dev,prod"},
+	})}
+	if err := validateDashboardVariables([]interface{}{}, previous, previous); err != nil {
+		t.Errorf("expected unchanged values not to be checked, got %v", err)
+	}
+
+	changed := []interface{}{exampleTerraformVariable("env", map[string]interface{}{
+		"csv_variable_source_definition": map[string]interface{}{"values": "prod,dev,test,prod"},
+	})}
+	err := validateDashboardVariables([]interface{}{}, changed, previous)
+	if err == nil || !strings.Contains(err.Error(), "value prod is specified more than once") {
+		t.Errorf("expected changed values to be checked, got %v", err)
+	}
+}
+
+func TestValidateLookupTableVariables(t *testing.T) {
+	variables := []interface{}{
+		exampleTerraformVariable("region", map[string]interface{}{
+			"lookup_table_variable_source_definition": map[string]interface{}{
+				"lookup_table_id": "0000000000000001",
+				"field":           "zone",
+			},
+		}),
+	}
+	body := []byte(`{
+		"id": "0000000000000001",
+		"name": "regions",
+		"fields": [{"fieldName": "region", "fieldType": "string"}, {"fieldName": "team", "fieldType": "string"}],
+		"primaryKeys": ["region"]
+	}`)
+	client := newTestClient(&http.Response{
+		Status:     http.StatusText(200),
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(bytes.NewReader(body)),
+	})
+	err := validateLookupTableVariables(client, variables)
+	if err == nil || !strings.Contains(err.Error(), "has no field zone, its fields are: [region, team]") {
+		t.Errorf("expected missing field error, got %v", err)
+	}
+}
@@ -0,0 +1,488 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const visualSettingsConfigFieldName = "visual_settings_config"
+
+var (
+	validVisualSettingsChartTypes = []string{
+		"area", "bar", "box", "column", "honeyComb", "line", "map", "pie", "sankey", "scatter", "svp", "table",
+	}
+	validVisualSettingsSeriesChartTypes = []string{"area", "column", "line"}
+	visualSettingsColorValidation       = validation.StringMatch(
+		regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`), "must be a hex color, e.g. #98ECA9")
+)
+
+// visualSettingsLeaf maps an attribute of visual_settings_config, or of one of its nested blocks,
+// to the dot separated path of the key it manages in the visual settings JSON.
+type visualSettingsLeaf struct {
+	block     string
+	attribute string
+	path      string
+}
+
+var visualSettingsLeaves = []visualSettingsLeaf{
+	{attribute: "chart_type", path: "general.type"},
+	{attribute: "color_palette", path: "color.family"},
+	{block: "axis_x", attribute: "title", path: "axes.axisX.title"},
+	{block: "axis_x", attribute: "title_font_size", path: "axes.axisX.titleFontSize"},
+	{block: "axis_x", attribute: "label_font_size", path: "axes.axisX.labelFontSize"},
+	{block: "axis_x", attribute: "hide_labels", path: "axes.axisX.hideLabels"},
+	{block: "axis_x", attribute: "logarithmic", path: "axes.axisX.logarithmic"},
+	{block: "axis_y", attribute: "title", path: "axes.axisY.title"},
+	{block: "axis_y", attribute: "title_font_size", path: "axes.axisY.titleFontSize"},
+	{block: "axis_y", attribute: "label_font_size", path: "axes.axisY.labelFontSize"},
+	{block: "axis_y", attribute: "hide_labels", path: "axes.axisY.hideLabels"},
+	{block: "axis_y", attribute: "logarithmic", path: "axes.axisY.logarithmic"},
+	{block: "legend", attribute: "enabled", path: "legend.enabled"},
+	{block: "legend", attribute: "vertical_align", path: "legend.verticalAlign"},
+	{block: "legend", attribute: "font_size", path: "legend.fontSize"},
+	{block: "legend", attribute: "show_as_table", path: "legend.showAsTable"},
+	{block: "threshold_settings", attribute: "show_thresholds", path: "thresholdsSettings.showThresholds"},
+	{block: "threshold_settings", attribute: "fill_remaining_green", path: "thresholdsSettings.fillRemainingGreen"},
+}
+
+const (
+	visualSettingsOverridesPath  = "overrides"
+	visualSettingsThresholdsPath = "thresholdsSettings.thresholds"
+)
+
+func getVisualSettingsConfigSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"chart_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(validVisualSettingsChartTypes, false),
+		},
+		"color_palette": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"axis_x": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: getVisualSettingsAxisSchema(),
+			},
+		},
+		"axis_y": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: getVisualSettingsAxisSchema(),
+			},
+		},
+		"legend": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+					"vertical_align": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"top", "center", "bottom"}, false),
+					},
+					"font_size": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+					"show_as_table": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+		"series_override": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"queries": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"series": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"chart_type": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(validVisualSettingsSeriesChartTypes, false),
+					},
+					"color": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: visualSettingsColorValidation,
+					},
+				},
+			},
+		},
+		"threshold_settings": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"show_thresholds": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+					"fill_remaining_green": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"threshold": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"from": getVisualSettingsThresholdBoundSchema(),
+								"to":   getVisualSettingsThresholdBoundSchema(),
+								"color": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: visualSettingsColorValidation,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// getVisualSettingsThresholdBoundSchema is a number given as a string, so that the bound of an open range
+// can be left unset instead of being sent as 0.
+func getVisualSettingsThresholdBoundSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ValidateFunc: validation.StringMatch(
+			regexp.MustCompile(`^-?(\d+\.?\d*|\.\d+)([eE][-+]?\d+)?$`), "must be a number, e.g. 50 or 99.5"),
+		DiffSuppressFunc: suppressEquivalentThresholdBoundDiff,
+	}
+}
+
+func suppressEquivalentThresholdBoundDiff(_, old, new string, _ *schema.ResourceData) bool {
+	oldValue, oldErr := strconv.ParseFloat(old, 64)
+	newValue, newErr := strconv.ParseFloat(new, 64)
+	return oldErr == nil && newErr == nil && oldValue == newValue
+}
+
+func getVisualSettingsAxisSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"title": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"title_font_size": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"label_font_size": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"hide_labels": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"logarithmic": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+// getVisualSettings merges the typed visual_settings_config into the raw visual_settings JSON of a
+// panel. Keys managed by visual_settings_config take precedence over the raw JSON.
+func getVisualSettings(visualSettings string, tfConfig []interface{}) (string, error) {
+	if len(tfConfig) == 0 || tfConfig[0] == nil {
+		return visualSettings, nil
+	}
+	config := tfConfig[0].(map[string]interface{})
+
+	settings, err := parseVisualSettings(visualSettings)
+	if err != nil {
+		return "", err
+	}
+
+	for _, leaf := range visualSettingsLeaves {
+		container := config
+		if leaf.block != "" {
+			block, ok := config[leaf.block].([]interface{})
+			if !ok || len(block) == 0 || block[0] == nil {
+				continue
+			}
+			container = block[0].(map[string]interface{})
+		}
+		switch value := container[leaf.attribute].(type) {
+		case string:
+			if value != "" {
+				setVisualSettingsPath(settings, leaf.path, value)
+			}
+		case int:
+			if value > 0 {
+				setVisualSettingsPath(settings, leaf.path, value)
+			}
+		case bool:
+			setVisualSettingsPath(settings, leaf.path, value)
+		}
+	}
+
+	if tfOverrides, ok := config["series_override"].([]interface{}); ok && len(tfOverrides) > 0 {
+		overrides := make([]interface{}, len(tfOverrides))
+		for i, val := range tfOverrides {
+			tfOverride := val.(map[string]interface{})
+			properties := make(map[string]interface{})
+			if chartType := tfOverride["chart_type"].(string); chartType != "" {
+				properties["type"] = chartType
+			}
+			if color := tfOverride["color"].(string); color != "" {
+				properties["color"] = color
+			}
+			overrides[i] = map[string]interface{}{
+				"queries":    tfOverride["queries"],
+				"series":     tfOverride["series"],
+				"properties": properties,
+			}
+		}
+		setVisualSettingsPath(settings, visualSettingsOverridesPath, overrides)
+	}
+
+	if block, ok := config["threshold_settings"].([]interface{}); ok && len(block) == 1 && block[0] != nil {
+		tfThresholds := block[0].(map[string]interface{})["threshold"].([]interface{})
+		thresholds := make([]interface{}, len(tfThresholds))
+		for i, val := range tfThresholds {
+			tfThreshold := val.(map[string]interface{})
+			threshold := map[string]interface{}{"color": tfThreshold["color"]}
+			for _, bound := range []string{"from", "to"} {
+				if value, ok := tfThreshold[bound].(string); ok && value != "" {
+					threshold[bound], _ = strconv.ParseFloat(value, 64)
+				}
+			}
+			thresholds[i] = threshold
+		}
+		setVisualSettingsPath(settings, visualSettingsThresholdsPath, thresholds)
+	}
+
+	bytes, err := json.Marshal(settings)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
+
+// getTerraformVisualSettings splits the visual settings JSON returned by the API into the keys managed
+// by visual_settings_config and the remaining raw JSON.
+func getTerraformVisualSettings(visualSettings string) (string, []map[string]interface{}, error) {
+	settings, err := parseVisualSettings(visualSettings)
+	if err != nil {
+		return "", nil, err
+	}
+
+	configSchema := getVisualSettingsConfigSchema()
+	config := make(map[string]interface{})
+	for _, leaf := range visualSettingsLeaves {
+		value, ok := getVisualSettingsPath(settings, leaf.path)
+		if !ok {
+			continue
+		}
+		deleteVisualSettingsPath(settings, leaf.path)
+
+		container, attributeSchema := config, configSchema[leaf.attribute]
+		if leaf.block != "" {
+			if _, ok := config[leaf.block]; !ok {
+				config[leaf.block] = []interface{}{map[string]interface{}{}}
+			}
+			container = config[leaf.block].([]interface{})[0].(map[string]interface{})
+			attributeSchema = configSchema[leaf.block].Elem.(*schema.Resource).Schema[leaf.attribute]
+		}
+		switch attributeSchema.Type {
+		case schema.TypeInt:
+			if number, ok := value.(float64); ok {
+				container[leaf.attribute] = int(number)
+			}
+		default:
+			container[leaf.attribute] = value
+		}
+	}
+
+	if value, ok := getVisualSettingsPath(settings, visualSettingsOverridesPath); ok {
+		deleteVisualSettingsPath(settings, visualSettingsOverridesPath)
+		overrides, _ := value.([]interface{})
+		tfOverrides := make([]interface{}, 0, len(overrides))
+		for _, val := range overrides {
+			override, ok := val.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			tfOverride := map[string]interface{}{
+				"queries": override["queries"],
+				"series":  override["series"],
+			}
+			if properties, ok := override["properties"].(map[string]interface{}); ok {
+				tfOverride["chart_type"] = properties["type"]
+				tfOverride["color"] = properties["color"]
+			}
+			tfOverrides = append(tfOverrides, tfOverride)
+		}
+		config["series_override"] = tfOverrides
+	}
+
+	if value, ok := getVisualSettingsPath(settings, visualSettingsThresholdsPath); ok {
+		deleteVisualSettingsPath(settings, visualSettingsThresholdsPath)
+		thresholds, _ := value.([]interface{})
+		tfThresholds := make([]interface{}, 0, len(thresholds))
+		for _, val := range thresholds {
+			if threshold, ok := val.(map[string]interface{}); ok {
+				tfThreshold := map[string]interface{}{"from": "", "to": "", "color": threshold["color"]}
+				for _, bound := range []string{"from", "to"} {
+					if value, ok := threshold[bound].(float64); ok {
+						tfThreshold[bound] = strconv.FormatFloat(value, 'f', -1, 64)
+					}
+				}
+				tfThresholds = append(tfThresholds, tfThreshold)
+			}
+		}
+		if _, ok := config["threshold_settings"]; !ok {
+			config["threshold_settings"] = []interface{}{map[string]interface{}{}}
+		}
+		config["threshold_settings"].([]interface{})[0].(map[string]interface{})["threshold"] = tfThresholds
+	}
+
+	remainder := ""
+	if len(settings) > 0 {
+		bytes, err := json.Marshal(settings)
+		if err != nil {
+			return "", nil, err
+		}
+		remainder = string(bytes)
+	}
+	return remainder, []map[string]interface{}{config}, nil
+}
+
+// validateVisualSettingsConfig checks that the raw visual_settings JSON of a panel doesn't set any
+// key managed by its visual_settings_config, as the two would otherwise fight over the key.
+func validateVisualSettingsConfig(visualSettings string, tfConfig []interface{}) error {
+	if len(tfConfig) == 0 || tfConfig[0] == nil {
+		return nil
+	}
+
+	if tfOverrides, ok := tfConfig[0].(map[string]interface{})["series_override"].([]interface{}); ok {
+		for _, val := range tfOverrides {
+			tfOverride := val.(map[string]interface{})
+			if len(tfOverride["queries"].([]interface{})) == 0 && len(tfOverride["series"].([]interface{})) == 0 {
+				return fmt.Errorf("series_override must specify at least one of queries or series")
+			}
+		}
+	}
+
+	if visualSettings == "" {
+		return nil
+	}
+	settings, err := parseVisualSettings(visualSettings)
+	if err != nil {
+		return err
+	}
+	paths := []string{visualSettingsOverridesPath, visualSettingsThresholdsPath}
+	for _, leaf := range visualSettingsLeaves {
+		paths = append(paths, leaf.path)
+	}
+	for _, path := range paths {
+		if _, ok := getVisualSettingsPath(settings, path); ok {
+			return fmt.Errorf("visual_settings must not set %s when %s is specified, as the key is managed by %s",
+				path, visualSettingsConfigFieldName, visualSettingsConfigFieldName)
+		}
+	}
+	return nil
+}
+
+func parseVisualSettings(visualSettings string) (map[string]interface{}, error) {
+	settings := make(map[string]interface{})
+	if visualSettings == "" {
+		return settings, nil
+	}
+	if err := json.Unmarshal([]byte(visualSettings), &settings); err != nil {
+		return nil, fmt.Errorf("invalid visual_settings: %s", err)
+	}
+	return settings, nil
+}
+
+func getVisualSettingsPath(settings map[string]interface{}, path string) (interface{}, bool) {
+	keys := strings.Split(path, ".")
+	for _, key := range keys[:len(keys)-1] {
+		nested, ok := settings[key].(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		settings = nested
+	}
+	value, ok := settings[keys[len(keys)-1]]
+	return value, ok
+}
+
+func setVisualSettingsPath(settings map[string]interface{}, path string, value interface{}) {
+	keys := strings.Split(path, ".")
+	for _, key := range keys[:len(keys)-1] {
+		nested, ok := settings[key].(map[string]interface{})
+		if !ok {
+			nested = make(map[string]interface{})
+			settings[key] = nested
+		}
+		settings = nested
+	}
+	settings[keys[len(keys)-1]] = value
+}
+
+// deleteVisualSettingsPath removes the key at path, along with any parent objects left empty.
+func deleteVisualSettingsPath(settings map[string]interface{}, path string) {
+	keys := strings.Split(path, ".")
+	if len(keys) == 1 {
+		delete(settings, keys[0])
+		return
+	}
+	nested, ok := settings[keys[0]].(map[string]interface{})
+	if !ok {
+		return
+	}
+	deleteVisualSettingsPath(nested, strings.Join(keys[1:], "."))
+	if len(nested) == 0 {
+		delete(settings, keys[0])
+	}
+}
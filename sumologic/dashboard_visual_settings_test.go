@@ -0,0 +1,210 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func exampleVisualSettingsConfig() []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"chart_type":    "column",
+			"color_palette": "Categorical Default",
+			"axis_x":        []interface{}{},
+			"axis_y": []interface{}{
+				map[string]interface{}{
+					"title":           "Errors",
+					"title_font_size": 12,
+					"label_font_size": 0,
+					"hide_labels":     false,
+					"logarithmic":     true,
+				},
+			},
+			"legend": []interface{}{
+				map[string]interface{}{
+					"enabled":        true,
+					"vertical_align": "bottom",
+					"font_size":      12,
+					"show_as_table":  false,
+				},
+			},
+			"series_override": []interface{}{
+				map[string]interface{}{
+					"queries":    []interface{}{"A"},
+					"series":     []interface{}{},
+					"chart_type": "line",
+					"color":      "#98ECA9",
+				},
+			},
+			"threshold_settings": []interface{}{
+				map[string]interface{}{
+					"show_thresholds":      true,
+					"fill_remaining_green": false,
+					"threshold": []interface{}{
+						map[string]interface{}{"from": "0", "to": "50", "color": "#98ECA9"},
+						map[string]interface{}{"from": "50", "to": "99.5", "color": "#FFB5B5"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestGetVisualSettings_roundTrip(t *testing.T) {
+	rawSettings := `{"general": {"mode": "timeSeries", "markerSize": 5}, "axes": {"axisY": {"minimum": 0}}}`
+
+	visualSettings, err := getVisualSettings(rawSettings, exampleVisualSettingsConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var merged map[string]interface{}
+	if err := json.Unmarshal([]byte(visualSettings), &merged); err != nil {
+		t.Fatal(err)
+	}
+	if general := merged["general"].(map[string]interface{}); general["type"] != "column" || general["mode"] != "timeSeries" {
+		t.Errorf("expected chart type to be merged into general settings, got %v", general)
+	}
+	axisY := merged["axes"].(map[string]interface{})["axisY"].(map[string]interface{})
+	if axisY["title"] != "Errors" || axisY["minimum"] != 0.0 {
+		t.Errorf("expected axis settings to be merged, got %v", axisY)
+	}
+	if _, ok := axisY["labelFontSize"]; ok {
+		t.Errorf("expected unset label_font_size to be omitted, got %v", axisY)
+	}
+
+	remainder, tfConfig, err := getTerraformVisualSettings(visualSettings)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var expectedRemainder, actualRemainder map[string]interface{}
+	json.Unmarshal([]byte(rawSettings), &expectedRemainder)
+	json.Unmarshal([]byte(remainder), &actualRemainder)
+	if !reflect.DeepEqual(expectedRemainder, actualRemainder) {
+		t.Errorf("expected remaining visual settings %v, got %v", expectedRemainder, actualRemainder)
+	}
+
+	// The API returns the settings as JSON, so compare after converting the expected lists the same way.
+	expectedConfig := exampleVisualSettingsConfig()[0].(map[string]interface{})
+	delete(expectedConfig, "axis_x")
+	delete(expectedConfig["axis_y"].([]interface{})[0].(map[string]interface{}), "label_font_size")
+	if !reflect.DeepEqual(jsonRoundTrip(t, expectedConfig), jsonRoundTrip(t, tfConfig[0])) {
+		t.Errorf("expected visual_settings_config\n%v\ngot\n%v", expectedConfig, tfConfig[0])
+	}
+}
+
+func TestGetVisualSettings_openThresholdRange(t *testing.T) {
+	tfConfig := []interface{}{
+		map[string]interface{}{
+			"threshold_settings": []interface{}{
+				map[string]interface{}{
+					"show_thresholds":      true,
+					"fill_remaining_green": false,
+					"threshold": []interface{}{
+						map[string]interface{}{"from": "", "to": "0", "color": "#98ECA9"},
+						map[string]interface{}{"from": "100", "to": "", "color": "#FFB5B5"},
+					},
+				},
+			},
+		},
+	}
+
+	visualSettings, err := getVisualSettings("", tfConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var merged map[string]interface{}
+	if err := json.Unmarshal([]byte(visualSettings), &merged); err != nil {
+		t.Fatal(err)
+	}
+	thresholds := merged["thresholdsSettings"].(map[string]interface{})["thresholds"].([]interface{})
+	expected := []interface{}{
+		map[string]interface{}{"to": 0.0, "color": "#98ECA9"},
+		map[string]interface{}{"from": 100.0, "color": "#FFB5B5"},
+	}
+	if !reflect.DeepEqual(thresholds, expected) {
+		t.Errorf("expected unset bounds to be omitted, got %v", thresholds)
+	}
+
+	_, readConfig, err := getTerraformVisualSettings(visualSettings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	readThresholds := readConfig[0]["threshold_settings"].([]interface{})[0].(map[string]interface{})["threshold"]
+	if !reflect.DeepEqual(readThresholds, tfConfig[0].(map[string]interface{})["threshold_settings"].([]interface{})[0].(map[string]interface{})["threshold"]) {
+		t.Errorf("expected the open ranges to be read back unchanged, got %v", readThresholds)
+	}
+}
+
+func TestSuppressEquivalentThresholdBoundDiff(t *testing.T) {
+	if !suppressEquivalentThresholdBoundDiff("", "50", "50.0", nil) {
+		t.Error("expected 50 and 50.0 to be equivalent")
+	}
+	if suppressEquivalentThresholdBoundDiff("", "", "0", nil) {
+		t.Error("expected an unset bound and 0 to differ")
+	}
+}
+
+func TestGetVisualSettings_withoutConfig(t *testing.T) {
+	rawSettings := `{"general": {"type": "line"}}`
+	visualSettings, err := getVisualSettings(rawSettings, []interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if visualSettings != rawSettings {
+		t.Errorf("expected visual settings to be unchanged, got %s", visualSettings)
+	}
+
+	remainder, _, err := getTerraformVisualSettings(rawSettings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if remainder != "" {
+		t.Errorf("expected no remaining visual settings, got %s", remainder)
+	}
+}
+
+func TestValidateVisualSettingsConfig(t *testing.T) {
+	testCases := map[string]string{
+		`{"general": {"type": "line"}}`:                     "must not set general.type",
+		`{"legend": {"enabled": false}}`:                    "must not set legend.enabled",
+		`{"thresholdsSettings": {"thresholds": []}}`:        "must not set thresholdsSettings.thresholds",
+		`{"overrides": []}`:                                 "must not set overrides",
+		`{"general": {"type": "line"}, "title": {"fontSize`: "invalid visual_settings",
+	}
+	for visualSettings, expectedError := range testCases {
+		err := validateVisualSettingsConfig(visualSettings, exampleVisualSettingsConfig())
+		if err == nil || !strings.Contains(err.Error(), expectedError) {
+			t.Errorf("expected error for %s to contain %q, got %v", visualSettings, expectedError, err)
+		}
+	}
+
+	if err := validateVisualSettingsConfig(`{"general": {"mode": "timeSeries"}}`, exampleVisualSettingsConfig()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := validateVisualSettingsConfig(`{"general": {"type": "line"}}`, []interface{}{}); err != nil {
+		t.Errorf("unexpected error without visual_settings_config: %v", err)
+	}
+
+	config := exampleVisualSettingsConfig()
+	override := config[0].(map[string]interface{})["series_override"].([]interface{})[0].(map[string]interface{})
+	override["queries"] = []interface{}{}
+	if err := validateVisualSettingsConfig("", config); err == nil || !strings.Contains(err.Error(), "at least one of queries or series") {
+		t.Errorf("expected series_override error, got %v", err)
+	}
+}
+
+func jsonRoundTrip(t *testing.T, value interface{}) interface{} {
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var result interface{}
+	if err := json.Unmarshal(bytes, &result); err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
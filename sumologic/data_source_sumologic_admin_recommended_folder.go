@@ -1,13 +1,16 @@
 package sumologic
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceSumologicAdminRecommendedFolder() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceSumologicAdminRecommendedFolderRead,
+		ReadContext: dataSourceSumologicAdminRecommendedFolderRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -32,13 +35,13 @@ func dataSourceSumologicAdminRecommendedFolder() *schema.Resource {
 	}
 }
 
-func dataSourceSumologicAdminRecommendedFolderRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceSumologicAdminRecommendedFolderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*Client)
 
-	adminRecommendedFolder, err := c.getAdminRecommendedFolder(d.Timeout(schema.TimeoutRead))
+	adminRecommendedFolder, err := c.getAdminRecommendedFolder(ctx, d.Timeout(schema.TimeoutRead))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(adminRecommendedFolder.ID)
@@ -0,0 +1,62 @@
+package sumologic
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceSumologicContent() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSumologicContentRead,
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parent_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSumologicContentRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	path := d.Get("path").(string)
+	content, err := c.GetContentByPath(path)
+	if err != nil {
+		return err
+	}
+	if content == nil {
+		return fmt.Errorf("content with path '%s' does not exist", path)
+	}
+
+	d.SetId(content.ID)
+	d.Set("type", content.ItemType)
+	d.Set("name", content.Name)
+	d.Set("parent_id", content.ParentId)
+	d.Set("created_by", content.CreatedBy)
+
+	return nil
+}
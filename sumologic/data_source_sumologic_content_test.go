@@ -0,0 +1,110 @@
+package sumologic
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestGetContentByPath(t *testing.T) {
+	client, httpClient := newRoutingTestClient(map[string]string{
+		"v2/content/path": `{
+			"id": "0000000000000004",
+			"name": "Errors",
+			"itemType": "Search",
+			"parentId": "0000000000000002",
+			"createdBy": "0000000000000010"
+		}`,
+	})
+
+	content, err := client.GetContentByPath("/Library/Users/user@example.com/Team/Searches/Errors")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := ContentItem{
+		ID:        "0000000000000004",
+		Name:      "Errors",
+		ItemType:  "Search",
+		ParentId:  "0000000000000002",
+		CreatedBy: "0000000000000010",
+	}
+	if content == nil || !reflect.DeepEqual(*content, expected) {
+		t.Errorf("expected content %+v, got %+v", expected, content)
+	}
+	if len(httpClient.requests) != 1 {
+		t.Errorf("expected a single request, got %v", httpClient.requests)
+	}
+
+	client, _ = newRoutingTestClient(map[string]string{})
+	content, err = client.GetContentByPath("/Library/Users/user@example.com/doesNotExist")
+	if err != nil || content != nil {
+		t.Errorf("expected no content for a path that does not exist, got %+v, %v", content, err)
+	}
+}
+
+func TestAccDataSourceSumologicContent_basic(t *testing.T) {
+	name := "terraform_test_content_" + acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSumologicContentConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sumologic_content.test", "id",
+						"sumologic_folder.child", "id"),
+					resource.TestCheckResourceAttr("data.sumologic_content.test", "type", "Folder"),
+					resource.TestCheckResourceAttr("data.sumologic_content.test", "name", "child"),
+					resource.TestCheckResourceAttrPair("data.sumologic_content.test", "parent_id",
+						"sumologic_folder.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceSumologicContent_does_not_exist(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "sumologic_content" "test" {
+  path = "/Library/Users/doesNotExist/doesNotExist"
+}
+`,
+				ExpectError: regexp.MustCompile(`content with path '/Library/Users/doesNotExist/doesNotExist' does not exist`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSumologicContentConfig(name string) string {
+	return fmt.Sprintf(`
+data "sumologic_personal_folder" "personalFolder" {}
+resource "sumologic_folder" "test" {
+  name = "%s"
+  parent_id = data.sumologic_personal_folder.personalFolder.id
+  description = "test"
+}
+resource "sumologic_folder" "child" {
+  name = "child"
+  parent_id = sumologic_folder.test.id
+  description = "test"
+}
+data "sumologic_content_tree" "test" {
+  folder_id = sumologic_folder.test.id
+  max_depth = 1
+  depends_on = [sumologic_folder.child]
+}
+data "sumologic_content" "test" {
+  path = "${data.sumologic_content_tree.test.path}/child"
+}
+`, name)
+}
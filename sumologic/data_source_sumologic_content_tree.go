@@ -0,0 +1,144 @@
+package sumologic
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceSumologicContentTree() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSumologicContentTreeRead,
+
+		Schema: map[string]*schema.Schema{
+			"folder_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"admin_recommended"},
+			},
+			"admin_recommended": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"folder_id"},
+			},
+			"content_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"max_depth": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"depth": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func dataSourceSumologicContentTreeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*Client)
+
+	filter := ContentTreeFilter{
+		MaxDepth: d.Get("max_depth").(int),
+	}
+	for _, contentType := range d.Get("content_types").(*schema.Set).List() {
+		filter.ItemTypes = append(filter.ItemTypes, contentType.(string))
+	}
+	if nameRegex, ok := d.GetOk("name_regex"); ok {
+		filter.NameRegex = regexp.MustCompile(nameRegex.(string))
+	}
+
+	adminRecommended := d.Get("admin_recommended").(bool)
+	folderId := d.Get("folder_id").(string)
+	if folderId == "" && !adminRecommended {
+		personalFolder, err := c.getPersonalFolder()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		folderId = personalFolder.ID
+	}
+
+	root, items, err := c.GetContentTree(ctx, folderId, adminRecommended, filter, d.Timeout(schema.TimeoutRead))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tfItems := make([]map[string]interface{}, len(items))
+	for i, item := range items {
+		tfItems[i] = map[string]interface{}{
+			"id":        item.ID,
+			"type":      item.ItemType,
+			"name":      item.Name,
+			"path":      item.Path,
+			"parent_id": item.ParentId,
+			"depth":     item.Depth,
+		}
+	}
+
+	d.SetId(root.ID)
+	d.Set("name", root.Name)
+	d.Set("path", root.Path)
+	if err := d.Set("items", tfItems); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
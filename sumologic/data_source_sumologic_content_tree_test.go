@@ -0,0 +1,65 @@
+package sumologic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSumologicContentTree_basic(t *testing.T) {
+	name := "terraform_test_content_tree_" + acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSumologicContentTreeConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sumologic_content_tree.test", "id",
+						"sumologic_folder.root", "id"),
+					resource.TestCheckResourceAttr("data.sumologic_content_tree.test", "items.#", "2"),
+					resource.TestCheckResourceAttr("data.sumologic_content_tree.test", "items.0.name", "child"),
+					resource.TestCheckResourceAttr("data.sumologic_content_tree.test", "items.0.type", "Folder"),
+					resource.TestCheckResourceAttr("data.sumologic_content_tree.test", "items.1.name", "grandchild"),
+					resource.TestCheckResourceAttrPair("data.sumologic_content_tree.test", "items.1.parent_id",
+						"sumologic_folder.child", "id"),
+					resource.TestCheckResourceAttr("data.sumologic_content_tree.filtered", "items.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSumologicContentTreeConfig(name string) string {
+	return fmt.Sprintf(`
+data "sumologic_personal_folder" "personalFolder" {}
+resource "sumologic_folder" "root" {
+  name = "%s"
+  parent_id = data.sumologic_personal_folder.personalFolder.id
+  description = "test"
+}
+resource "sumologic_folder" "child" {
+  name = "child"
+  parent_id = sumologic_folder.root.id
+  description = "test"
+}
+resource "sumologic_folder" "grandchild" {
+  name = "grandchild"
+  parent_id = sumologic_folder.child.id
+  description = "test"
+}
+data "sumologic_content_tree" "test" {
+  folder_id = sumologic_folder.root.id
+  depends_on = [sumologic_folder.grandchild]
+}
+data "sumologic_content_tree" "filtered" {
+  folder_id = sumologic_folder.root.id
+  name_regex = "^grand"
+  content_types = ["Folder"]
+  depends_on = [sumologic_folder.grandchild]
+}
+`, name)
+}
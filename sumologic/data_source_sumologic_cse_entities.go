@@ -0,0 +1,146 @@
+package sumologic
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceSumologicCSEEntities() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSumologicCSEEntitiesRead,
+		Schema: map[string]*schema.Schema{
+			"query": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"entity_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"suffix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"network_block": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"criticality": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"entities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"entity_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"criticality": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"suppressed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSumologicCSEEntitiesRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	search := CSEEntitySearch{
+		Query:       d.Get("query").(string),
+		EntityType:  d.Get("entity_type").(string),
+		Prefix:      d.Get("prefix").(string),
+		Suffix:      d.Get("suffix").(string),
+		Tags:        resourceToStringArray(d.Get("tags").(*schema.Set).List()),
+		Criticality: d.Get("criticality").(string),
+	}
+	if nameRegex, ok := d.GetOk("name_regex"); ok {
+		search.NameRegex = regexp.MustCompile(nameRegex.(string))
+	}
+	if networkBlock, ok := d.GetOk("network_block"); ok {
+		_, search.NetworkBlock, _ = net.ParseCIDR(networkBlock.(string))
+	}
+
+	entities, err := c.SearchCSEEntities(search)
+	if err != nil {
+		return fmt.Errorf("error retrieving CSE entities: %v", err)
+	}
+
+	ids := make([]string, 0, len(entities))
+	terraformEntities := make([]map[string]interface{}, 0, len(entities))
+	for _, entity := range entities {
+		ids = append(ids, entity.ID)
+		terraformEntities = append(terraformEntities, map[string]interface{}{
+			"id":          entity.ID,
+			"name":        entity.Name,
+			"entity_type": entity.EntityType,
+			"criticality": entity.Criticality,
+			"suppressed":  entity.Suppressed,
+			"tags":        entity.Tags,
+		})
+	}
+
+	d.Set("ids", ids)
+	d.Set("entities", terraformEntities)
+	d.SetId(generateCSERulesId(ids))
+
+	return nil
+}
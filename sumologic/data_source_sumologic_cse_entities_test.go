@@ -0,0 +1,108 @@
+package sumologic
+
+import (
+	"net"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+const exampleCSEEntities = `{"data": {"total": 4, "objects": [
+	{"id": "_ip-10.0.0.1", "name": "10.0.0.1", "entityType": "_ip", "criticality": "HIGH", "tags": ["pci", "prod"]},
+	{"id": "_ip-192.168.1.5", "name": "192.168.1.5", "entityType": "_ip", "tags": ["prod"]},
+	{"id": "_hostname-web-01.example.com", "name": "WEB-01.example.com", "entityType": "_hostname", "criticality": "HIGH", "tags": ["pci"], "isSuppressed": true},
+	{"id": "_hostname-db-01.example.com", "name": "db-01.example.com", "entityType": "_hostname", "namespace": "corp"}
+]}}`
+
+func TestDataSourceSumologicCSEEntitiesRead(t *testing.T) {
+	client, _ := newRoutingTestClient(map[string]string{
+		"sec/v1/entities": exampleCSEEntities,
+	})
+
+	d := dataSourceSumologicCSEEntities().Data(nil)
+	d.Set("tags", []interface{}{"pci"})
+	d.Set("criticality", "HIGH")
+
+	err := dataSourceSumologicCSEEntitiesRead(d, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedIds := []interface{}{"_ip-10.0.0.1", "_hostname-web-01.example.com"}
+	if ids := d.Get("ids"); !reflect.DeepEqual(ids, expectedIds) {
+		t.Errorf("expected ids %v, got %v", expectedIds, ids)
+	}
+	if name := d.Get("entities.1.name"); name != "WEB-01.example.com" {
+		t.Errorf("expected the name of the second entity to be set, got %v", name)
+	}
+	if !d.Get("entities.1.suppressed").(bool) {
+		t.Errorf("expected the second entity to be suppressed")
+	}
+	if d.Id() == "" {
+		t.Errorf("expected the id to be set")
+	}
+}
+
+func TestCSEEntitySearch_matches(t *testing.T) {
+	client, _ := newRoutingTestClient(map[string]string{
+		"sec/v1/entities": exampleCSEEntities,
+	})
+
+	_, networkBlock, _ := net.ParseCIDR("10.0.0.0/8")
+	for _, tc := range []struct {
+		name        string
+		search      CSEEntitySearch
+		expectedIds []string
+	}{
+		{"all", CSEEntitySearch{}, []string{"_ip-10.0.0.1", "_ip-192.168.1.5", "_hostname-web-01.example.com", "_hostname-db-01.example.com"}},
+		{"entity type", CSEEntitySearch{EntityType: "_ip"}, []string{"_ip-10.0.0.1", "_ip-192.168.1.5"}},
+		{"name regex", CSEEntitySearch{NameRegex: regexp.MustCompile("^db-")}, []string{"_hostname-db-01.example.com"}},
+		{"prefix ignores case", CSEEntitySearch{Prefix: "web-"}, []string{"_hostname-web-01.example.com"}},
+		{"suffix", CSEEntitySearch{Suffix: ".EXAMPLE.COM"}, []string{"_hostname-web-01.example.com", "_hostname-db-01.example.com"}},
+		{"network block", CSEEntitySearch{NetworkBlock: networkBlock}, []string{"_ip-10.0.0.1"}},
+		{"namespace", CSEEntitySearch{Namespace: "corp"}, []string{"_hostname-db-01.example.com"}},
+		{"tags", CSEEntitySearch{Tags: []string{"pci", "prod"}}, []string{"_ip-10.0.0.1"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			entities, err := client.SearchCSEEntities(tc.search)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids := make([]string, 0, len(entities))
+			for _, entity := range entities {
+				ids = append(ids, entity.ID)
+			}
+			if !reflect.DeepEqual(ids, tc.expectedIds) {
+				t.Errorf("expected ids %v, got %v", tc.expectedIds, ids)
+			}
+		})
+	}
+}
+
+func TestCountCSEEntities(t *testing.T) {
+	client, httpClient := newRoutingTestClient(map[string]string{
+		"sec/v1/entities": exampleCSEEntities,
+	})
+
+	count, err := client.CountCSEEntities(CSEEntitySearch{EntityType: "_ip"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Errorf("expected the total of the search to be the count, got %d", count)
+	}
+
+	// The network block is only matched on the client, so the entities are listed to count them.
+	_, networkBlock, _ := net.ParseCIDR("10.0.0.0/8")
+	count, err = client.CountCSEEntities(CSEEntitySearch{EntityType: "_ip", NetworkBlock: networkBlock})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("expected the entities in the network block to be counted, got %d", count)
+	}
+	expectedQuery := `entityType:"_ip"`
+	if len(httpClient.queries) != 2 || httpClient.queries[1] != expectedQuery {
+		t.Errorf("expected the query %s, got %v", expectedQuery, httpClient.queries)
+	}
+}
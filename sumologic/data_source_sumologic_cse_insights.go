@@ -0,0 +1,198 @@
+package sumologic
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceSumologicCSEInsights() *schema.Resource {
+	insightsSchema := map[string]*schema.Schema{
+		"query": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"statuses": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		"severities": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"LOW", "MEDIUM", "HIGH", "CRITICAL"}, false),
+			},
+		},
+		"entity_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"entity_value": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"rule_ids": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		"tags": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		"ids": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"insight_count": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"insights": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"readable_id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"created": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"severity": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"status": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"resolution": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"assignee": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"entity_type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"entity_value": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"signal_ids": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"tags": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+	addCSESearchTimeRangeSchema(insightsSchema)
+
+	return &schema.Resource{
+		Read:   dataSourceSumologicCSEInsightsRead,
+		Schema: insightsSchema,
+	}
+}
+
+func dataSourceSumologicCSEInsightsRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	from, to := expandCSESearchTimeRange(d, time.Now())
+	search := CSEInsightSearch{
+		Query:       d.Get("query").(string),
+		From:        from,
+		To:          to,
+		Statuses:    resourceToStringArray(d.Get("statuses").(*schema.Set).List()),
+		Severities:  resourceToStringArray(d.Get("severities").(*schema.Set).List()),
+		EntityType:  d.Get("entity_type").(string),
+		EntityValue: d.Get("entity_value").(string),
+		RuleIDs:     resourceToStringArray(d.Get("rule_ids").(*schema.Set).List()),
+		Tags:        resourceToStringArray(d.Get("tags").(*schema.Set).List()),
+	}
+
+	insights, err := c.SearchCSEInsights(search)
+	if err != nil {
+		return fmt.Errorf("error retrieving CSE insights: %v", err)
+	}
+
+	ids := make([]string, 0, len(insights))
+	terraformInsights := make([]map[string]interface{}, 0, len(insights))
+	for _, insight := range insights {
+		ids = append(ids, insight.ID)
+
+		signalIds := make([]string, len(insight.Signals))
+		for i, signal := range insight.Signals {
+			signalIds[i] = signal.ID
+		}
+		assignee := ""
+		if insight.Assignee != nil {
+			assignee = insight.Assignee.Username
+		}
+
+		terraformInsights = append(terraformInsights, map[string]interface{}{
+			"id":           insight.ID,
+			"readable_id":  insight.ReadableID,
+			"name":         insight.Name,
+			"created":      insight.Created,
+			"severity":     insight.Severity,
+			"status":       insight.Status.Name,
+			"resolution":   insight.Resolution,
+			"assignee":     assignee,
+			"entity_type":  insight.Entity.EntityType,
+			"entity_value": insight.Entity.Value,
+			"signal_ids":   signalIds,
+			"tags":         insight.Tags,
+		})
+	}
+
+	d.Set("ids", ids)
+	d.Set("insight_count", len(ids))
+	d.Set("insights", terraformInsights)
+	d.SetId(generateCSERulesId(ids))
+
+	return nil
+}
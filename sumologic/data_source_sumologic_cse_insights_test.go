@@ -0,0 +1,48 @@
+package sumologic
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDataSourceSumologicCSEInsightsRead(t *testing.T) {
+	client, _ := newRoutingTestClient(map[string]string{
+		"sec/v1/insights": `{"data": {"total": 3, "objects": [
+			{"id": "a1", "readableId": "INSIGHT-1", "name": "Lateral movement", "created": "2024-03-04T10:00:00",
+				"severity": "HIGH", "status": {"name": "new", "displayName": "New"}, "assignee": {"username": "analyst"},
+				"entity": {"entityType": "_hostname", "value": "web-01"}, "tags": ["_mitreAttackTactic:TA0008"],
+				"signals": [{"id": "s1", "ruleId": "MATCH-S00001"}, {"id": "s2", "ruleId": "MATCH-S00002"}]},
+			{"id": "a2", "readableId": "INSIGHT-2", "name": "Lateral movement", "created": "2024-03-05T10:00:00",
+				"severity": "LOW", "status": {"name": "new"}, "entity": {"entityType": "_hostname", "value": "web-02"},
+				"signals": [{"id": "s3", "ruleId": "MATCH-S00001"}]},
+			{"id": "a3", "readableId": "INSIGHT-3", "name": "Credential access", "created": "2024-03-05T11:00:00",
+				"severity": "HIGH", "status": {"name": "closed"}, "resolution": "False Positive",
+				"entity": {"entityType": "_username", "value": "jdoe"}, "signals": [{"id": "s4", "ruleId": "MATCH-S00003"}]}
+		]}}`,
+	})
+
+	d := dataSourceSumologicCSEInsights().Data(nil)
+	d.Set("from", "2024-03-01T00:00:00Z")
+	d.Set("statuses", []interface{}{"NEW", "inprogress"})
+	d.Set("severities", []interface{}{"HIGH", "CRITICAL"})
+	d.Set("rule_ids", []interface{}{"MATCH-S00001"})
+
+	err := dataSourceSumologicCSEInsightsRead(d, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ids := d.Get("ids"); !reflect.DeepEqual(ids, []interface{}{"a1"}) {
+		t.Errorf("expected ids [a1], got %v", ids)
+	}
+	if count := d.Get("insight_count"); count != 1 {
+		t.Errorf("expected 1 insight, got %v", count)
+	}
+	expectedSignalIds := []interface{}{"s1", "s2"}
+	if signalIds := d.Get("insights.0.signal_ids"); !reflect.DeepEqual(signalIds, expectedSignalIds) {
+		t.Errorf("expected signal ids %v, got %v", expectedSignalIds, signalIds)
+	}
+	if d.Get("insights.0.status") != "new" || d.Get("insights.0.assignee") != "analyst" {
+		t.Errorf("unexpected insight %v", d.Get("insights.0"))
+	}
+}
@@ -0,0 +1,123 @@
+package sumologic
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceSumologicCSEInventory() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSumologicCSEInventoryRead,
+		Schema: map[string]*schema.Schema{
+			"inventory_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"user", "computer"}, false),
+			},
+			"inventory_source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"inventory_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"inventory_value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"inventory_key"},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"inventory": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"inventory_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"inventory_source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fields": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSumologicCSEInventoryRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	search := CSEInventorySearch{
+		InventoryType: d.Get("inventory_type").(string),
+		Source:        d.Get("inventory_source").(string),
+		Key:           d.Get("inventory_key").(string),
+		Value:         d.Get("inventory_value").(string),
+	}
+	if nameRegex, ok := d.GetOk("name_regex"); ok {
+		search.NameRegex = regexp.MustCompile(nameRegex.(string))
+	}
+
+	inventory, err := c.SearchCSEInventory(search)
+	if err != nil {
+		return fmt.Errorf("error retrieving CSE inventory: %v", err)
+	}
+
+	ids := make([]string, 0, len(inventory))
+	terraformInventory := make([]map[string]interface{}, 0, len(inventory))
+	for _, item := range inventory {
+		ids = append(ids, item.ID)
+		fields := make(map[string]interface{}, len(item.Fields))
+		for name, value := range item.Fields {
+			fields[name] = formatCSERecordValue(value)
+		}
+		terraformInventory = append(terraformInventory, map[string]interface{}{
+			"id":               item.ID,
+			"name":             item.Name,
+			"inventory_type":   item.InventoryType,
+			"inventory_source": item.Source,
+			"fields":           fields,
+		})
+	}
+
+	d.Set("ids", ids)
+	d.Set("inventory", terraformInventory)
+	d.SetId(generateCSERulesId(ids))
+
+	return nil
+}
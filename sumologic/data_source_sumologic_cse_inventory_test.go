@@ -0,0 +1,38 @@
+package sumologic
+
+import (
+	"reflect"
+	"testing"
+)
+
+const exampleCSEInventory = `{"data": {"total": 3, "objects": [
+	{"id": "1", "name": "jdoe", "inventoryType": "user", "source": "ActiveDirectory", "fields": {"groups": ["Domain Admins", "VPN"], "department": "IT"}},
+	{"id": "2", "name": "asmith", "inventoryType": "user", "source": "ActiveDirectory", "fields": {"groups": ["VPN"], "department": "Sales"}},
+	{"id": "3", "name": "svc-backup", "inventoryType": "user", "source": "ActiveDirectory", "fields": {"groups": []}}
+]}}`
+
+func TestDataSourceSumologicCSEInventoryRead(t *testing.T) {
+	client, _ := newRoutingTestClient(map[string]string{
+		"sec/v1/inventory": exampleCSEInventory,
+	})
+
+	d := dataSourceSumologicCSEInventory().Data(nil)
+	d.Set("inventory_type", "user")
+	d.Set("inventory_key", "groups")
+	d.Set("inventory_value", "domain admins")
+
+	err := dataSourceSumologicCSEInventoryRead(d, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ids := d.Get("ids"); !reflect.DeepEqual(ids, []interface{}{"1"}) {
+		t.Errorf("expected ids [1], got %v", ids)
+	}
+	if department := d.Get("inventory.0.fields.department"); department != "IT" {
+		t.Errorf("expected the fields of the inventory to be set, got %v", d.Get("inventory.0.fields"))
+	}
+	if source := d.Get("inventory.0.inventory_source"); source != "ActiveDirectory" {
+		t.Errorf("expected the inventory source to be set, got %v", source)
+	}
+}
@@ -0,0 +1,121 @@
+package sumologic
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceSumologicCSELogMappingPreview previews a log mapping, defined with the arguments of
+// sumologic_cse_log_mapping, against raw sample logs.
+func dataSourceSumologicCSELogMappingPreview() *schema.Resource {
+	logMappingSchema := resourceSumologicCSELogMapping().Schema
+	logMappingSchema["enabled"].Required = false
+	logMappingSchema["enabled"].Optional = true
+	logMappingSchema["enabled"].Default = true
+
+	logMappingSchema["raw_logs"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	logMappingSchema["results"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"dropped": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"errors": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"records": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"fields": {
+								Type:     schema.TypeMap,
+								Computed: true,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"json": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceSumologicCSELogMappingPreviewRead,
+		Schema: logMappingSchema,
+	}
+}
+
+func dataSourceSumologicCSELogMappingPreviewRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	logMapping := resourceToCSELogMapping(d)
+	logs := resourceStringArrayToStringArray(d.Get("raw_logs").([]interface{}))
+
+	previewResults, err := c.PreviewCSELogMapping(logMapping, logs)
+	if err != nil {
+		return fmt.Errorf("error previewing CSE log mapping %s: %v", logMapping.Name, err)
+	}
+
+	results := make([]map[string]interface{}, len(previewResults))
+	for i, previewResult := range previewResults {
+		records := make([]map[string]interface{}, len(previewResult.Records))
+		for j, record := range previewResult.Records {
+			fields := make(map[string]interface{}, len(record))
+			for name, value := range record {
+				fields[name] = formatCSERecordValue(value)
+			}
+			recordJson, err := json.Marshal(record)
+			if err != nil {
+				return err
+			}
+			records[j] = map[string]interface{}{
+				"fields": fields,
+				"json":   string(recordJson),
+			}
+		}
+		results[i] = map[string]interface{}{
+			"dropped": len(previewResult.Records) == 0,
+			"errors":  previewResult.Errors,
+			"records": records,
+		}
+	}
+
+	if err := d.Set("results", results); err != nil {
+		return err
+	}
+
+	logMappingJson, err := json.Marshal(logMapping)
+	if err != nil {
+		return err
+	}
+	hash := sha256.Sum256([]byte(string(logMappingJson) + "|" + strings.Join(logs, "|")))
+	d.SetId(hex.EncodeToString(hash[:]))
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// mockLogMappingPreviewHttpClient records the body of the preview request.
+type mockLogMappingPreviewHttpClient struct {
+	mockRoutingHttpClient
+	request CSELogMappingPreviewRequest
+}
+
+func (c *mockLogMappingPreviewHttpClient) Do(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		body, _ := ioutil.ReadAll(req.Body)
+		json.Unmarshal(body, &c.request)
+	}
+	return c.mockRoutingHttpClient.Do(req)
+}
+
+func TestDataSourceSumologicCSELogMappingPreviewRead(t *testing.T) {
+	client, _ := newRoutingTestClient(nil)
+	httpClient := &mockLogMappingPreviewHttpClient{mockRoutingHttpClient: mockRoutingHttpClient{bodies: map[string]string{
+		"sec/v1/log-mappings/test": `{"data": {"results": [
+			{"records": [{"srcDevice_ip": "10.0.0.1", "srcPort": 443, "normalizedAction": "allow"}]},
+			{"records": [], "errors": ["field srcDevice_ip: no value for path $.src"]}
+		]}}`,
+	}}}
+	client.httpClient = httpClient
+
+	d := dataSourceSumologicCSELogMappingPreview().Data(nil)
+	d.Set("name", "Firewall")
+	d.Set("product_guid", "003d35b3-3ba8-4e93-8776-e5810b4e243e")
+	d.Set("record_type", "Network")
+	d.Set("enabled", true)
+	d.Set("fields", []interface{}{
+		map[string]interface{}{"name": "srcDevice_ip", "value": "$.src"},
+		map[string]interface{}{"name": "normalizedAction", "value": "$.action", "lookup": []interface{}{
+			map[string]interface{}{"key": "permit", "value": "allow"},
+		}},
+	})
+	d.Set("raw_logs", []interface{}{
+		`{"src": "10.0.0.1", "port": 443, "action": "permit"}`,
+		`{"action": "deny"}`,
+	})
+
+	err := dataSourceSumologicCSELogMappingPreviewRead(d, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The mapping is sent as it would be created by sumologic_cse_log_mapping.
+	preview := httpClient.request.CSELogMappingPreview
+	if preview.LogMapping.Name != "Firewall" || len(preview.LogMapping.Fields) != 2 ||
+		(*preview.LogMapping.Fields[1].LookUp)[0].Value != "allow" {
+		t.Errorf("unexpected log mapping %+v", preview.LogMapping)
+	}
+	if len(preview.Logs) != 2 {
+		t.Errorf("expected the 2 raw logs to be sent, got %v", preview.Logs)
+	}
+
+	expectedFields := map[string]interface{}{"srcDevice_ip": "10.0.0.1", "srcPort": "443", "normalizedAction": "allow"}
+	if fields := d.Get("results.0.records.0.fields"); !reflect.DeepEqual(fields, expectedFields) {
+		t.Errorf("expected fields %v, got %v", expectedFields, fields)
+	}
+	if d.Get("results.0.dropped").(bool) || !d.Get("results.1.dropped").(bool) {
+		t.Errorf("expected only the second log to be dropped, got %v", d.Get("results"))
+	}
+	if errors := d.Get("results.1.errors").([]interface{}); len(errors) != 1 {
+		t.Errorf("expected the errors of the second log, got %v", errors)
+	}
+	if d.Id() == "" {
+		t.Errorf("expected the id to be set")
+	}
+}
@@ -0,0 +1,224 @@
+package sumologic
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceSumologicCSERuleTest() *schema.Resource {
+	severityMappingSchema := getSeverityMappingSchema()
+	severityMappingSchema.Required = false
+	severityMappingSchema.Optional = true
+	severityMappingSchema.ConflictsWith = []string{"severity"}
+
+	return &schema.Resource{
+		Read: dataSourceSumologicCSERuleTestRead,
+		Schema: map[string]*schema.Schema{
+			"expression": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"name_expression": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"summary_expression": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"severity": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntBetween(0, 10),
+				ConflictsWith: []string{"severity_mapping"},
+			},
+			"severity_mapping": severityMappingSchema,
+			"record": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"json": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsJSON,
+						},
+						"expected": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"match": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"signal_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"severity": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 10),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"matched": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"signal_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"summary": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSumologicCSERuleTestRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	tfRecords := d.Get("record").([]interface{})
+	rawRecords := make([]json.RawMessage, len(tfRecords))
+	records := make([]map[string]interface{}, len(tfRecords))
+	for i, tfRecord := range tfRecords {
+		rawRecords[i] = json.RawMessage(tfRecord.(map[string]interface{})["json"].(string))
+		err := json.Unmarshal(rawRecords[i], &records[i])
+		if err != nil {
+			return fmt.Errorf("record %d is not a JSON object: %v", i, err)
+		}
+	}
+
+	severityMapping := SeverityMapping{Type: "constant", Default: d.Get("severity").(int)}
+	if tfSeverityMapping := d.Get("severity_mapping").([]interface{}); len(tfSeverityMapping) == 1 {
+		severityMapping = resourceToSeverityMapping(tfSeverityMapping[0])
+	}
+
+	testResults, err := c.TestCSERule(CSERuleTest{
+		Expression:        d.Get("expression").(string),
+		NameExpression:    d.Get("name_expression").(string),
+		SummaryExpression: d.Get("summary_expression").(string),
+		SeverityMapping:   &severityMapping,
+		Records:           rawRecords,
+	})
+	if err != nil {
+		return fmt.Errorf("error testing CSE rule expression: %v", err)
+	}
+
+	var failures []string
+	results := make([]map[string]interface{}, len(records))
+	for i, record := range records {
+		result, err := cseRuleTestResult(testResults[i], record, d.Get("name_expression").(string),
+			d.Get("summary_expression").(string), severityMapping)
+		if err != nil {
+			return fmt.Errorf("record %d: %v", i, err)
+		}
+		results[i] = result
+
+		tfExpected := d.Get(fmt.Sprintf("record.%d.expected", i)).([]interface{})
+		failures = append(failures, unmetCSERuleTestExpectations(i, tfExpected, result)...)
+	}
+
+	d.Set("results", results)
+	if len(failures) > 0 {
+		return fmt.Errorf("CSE rule test failed:\n%s", strings.Join(failures, "\n"))
+	}
+
+	idParts := []string{d.Get("expression").(string)}
+	for _, rawRecord := range rawRecords {
+		idParts = append(idParts, string(rawRecord))
+	}
+	hash := sha256.Sum256([]byte(strings.Join(idParts, "|")))
+	d.SetId(hex.EncodeToString(hash[:]))
+
+	return nil
+}
+
+// cseRuleTestResult returns the outcome for a record. The signal name, summary and severity of a record
+// that matches are the ones returned by the rule test endpoint, or are rendered from the expressions and
+// the severity mapping of the rule if it doesn't return them.
+func cseRuleTestResult(testResult CSERuleTestRecordResult, record map[string]interface{}, nameExpression string,
+	summaryExpression string, severityMapping SeverityMapping) (map[string]interface{}, error) {
+
+	result := map[string]interface{}{
+		"matched":     testResult.Matched,
+		"signal_name": "",
+		"summary":     "",
+		"severity":    0,
+	}
+	if !testResult.Matched {
+		return result, nil
+	}
+
+	if testResult.SignalName != nil {
+		result["signal_name"] = *testResult.SignalName
+	} else {
+		result["signal_name"] = renderCSERuleTemplate(nameExpression, record)
+	}
+	if testResult.Summary != nil {
+		result["summary"] = *testResult.Summary
+	} else {
+		result["summary"] = renderCSERuleTemplate(summaryExpression, record)
+	}
+	if testResult.Severity != nil {
+		result["severity"] = *testResult.Severity
+	} else {
+		severity, err := severityMapping.severity(record)
+		if err != nil {
+			return nil, err
+		}
+		result["severity"] = severity
+	}
+	return result, nil
+}
+
+func unmetCSERuleTestExpectations(i int, tfExpected []interface{}, result map[string]interface{}) []string {
+	if len(tfExpected) == 0 || tfExpected[0] == nil {
+		return nil
+	}
+	expected := tfExpected[0].(map[string]interface{})
+
+	var failures []string
+	if expected["match"].(bool) != result["matched"].(bool) {
+		failures = append(failures, fmt.Sprintf("record %d: expected match to be %t, got %t", i,
+			expected["match"].(bool), result["matched"].(bool)))
+	}
+	if name := expected["signal_name"].(string); name != "" && name != result["signal_name"].(string) {
+		failures = append(failures, fmt.Sprintf("record %d: expected signal name '%s', got '%s'", i, name,
+			result["signal_name"].(string)))
+	}
+	if severity := expected["severity"].(int); severity != 0 && severity != result["severity"].(int) {
+		failures = append(failures, fmt.Sprintf("record %d: expected severity %d, got %d", i, severity,
+			result["severity"].(int)))
+	}
+	return failures
+}
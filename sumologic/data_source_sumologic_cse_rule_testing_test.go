@@ -0,0 +1,174 @@
+package sumologic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestCSERuleTemplateAndSeverity(t *testing.T) {
+	record := map[string]interface{}{
+		"srcDevice_ip":         "10.0.0.1",
+		"severity":             "high",
+		"normalizedSeverity":   float64(7),
+		"user_username":        "alice",
+		"metadata_deviceEvent": nil,
+	}
+
+	name := renderCSERuleTemplate("Login from {{srcDevice_ip}} by {{ user_username }}{{missing}}", record)
+	if name != "Login from 10.0.0.1 by alice" {
+		t.Errorf("expected rendered name, got '%s'", name)
+	}
+
+	testCases := []struct {
+		mapping  SeverityMapping
+		expected int
+	}{
+		{SeverityMapping{Type: "constant", Default: 3}, 3},
+		{SeverityMapping{Type: "fieldValue", Field: "normalizedSeverity", Default: 3}, 7},
+		{SeverityMapping{Type: "fieldValue", Field: "metadata_deviceEvent", Default: 3}, 3},
+		{SeverityMapping{Type: "fieldValueMapping", Field: "severity", Default: 3, Mapping: []SeverityMappingValueMapping{
+			{Type: "eq", From: "low", To: 2},
+			{Type: "eq", From: "high", To: 8},
+		}}, 8},
+		{SeverityMapping{Type: "fieldValueMapping", Field: "severity", Default: 3}, 3},
+	}
+	for _, testCase := range testCases {
+		if severity, err := testCase.mapping.severity(record); err != nil || severity != testCase.expected {
+			t.Errorf("expected severity %d for %+v, got %d (%v)", testCase.expected, testCase.mapping, severity, err)
+		}
+	}
+
+	_, err := SeverityMapping{Type: "fieldValueMapping", Field: "normalizedSeverity", Default: 3,
+		Mapping: []SeverityMappingValueMapping{{Type: "gt", From: "5", To: 8}}}.severity(record)
+	if err == nil || !strings.Contains(err.Error(), "only eq is supported") {
+		t.Errorf("expected mappings other than eq to be rejected, got %v", err)
+	}
+}
+
+func TestDataSourceSumologicCSERuleTestRead(t *testing.T) {
+	client, _ := newRoutingTestClient(map[string]string{
+		"sec/v1/rules/test": `{"data": {"results": [{"matched": true}, {"matched": false}]}}`,
+	})
+	d := dataSourceSumologicCSERuleTest().Data(nil)
+	d.Set("expression", "metadata_deviceEventId = 'Security-4625'")
+	d.Set("name_expression", "Failed login from {{srcDevice_ip}}")
+	d.Set("severity", 4)
+	d.Set("record", []interface{}{
+		map[string]interface{}{
+			"json": `{"metadata_deviceEventId": "Security-4625", "srcDevice_ip": "10.0.0.1"}`,
+			"expected": []interface{}{map[string]interface{}{
+				"match": true, "signal_name": "Failed login from 10.0.0.1", "severity": 4,
+			}},
+		},
+		map[string]interface{}{
+			"json": `{"metadata_deviceEventId": "Security-4624", "srcDevice_ip": "10.0.0.1"}`,
+			"expected": []interface{}{map[string]interface{}{
+				"match": false,
+			}},
+		},
+	})
+
+	err := dataSourceSumologicCSERuleTestRead(d, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("results.0.signal_name") != "Failed login from 10.0.0.1" || d.Get("results.0.severity") != 4 {
+		t.Errorf("expected signal of the matching record, got %v", d.Get("results.0"))
+	}
+	if d.Get("results.1.matched") != false || d.Get("results.1.signal_name") != "" {
+		t.Errorf("expected no signal for the record that doesn't match, got %v", d.Get("results.1"))
+	}
+
+	d.Set("record", []interface{}{
+		map[string]interface{}{
+			"json":     `{"metadata_deviceEventId": "Security-4625", "srcDevice_ip": "10.0.0.1"}`,
+			"expected": []interface{}{map[string]interface{}{"match": true, "severity": 8}},
+		},
+		map[string]interface{}{
+			"json":     `{"metadata_deviceEventId": "Security-4624", "srcDevice_ip": "10.0.0.1"}`,
+			"expected": []interface{}{map[string]interface{}{"match": true}},
+		},
+	})
+	err = dataSourceSumologicCSERuleTestRead(d, client)
+	if err == nil || !strings.Contains(err.Error(), "record 0: expected severity 8, got 4") ||
+		!strings.Contains(err.Error(), "record 1: expected match to be true, got false") {
+		t.Errorf("expected the unmet expectations to be reported, got %v", err)
+	}
+}
+
+func TestDataSourceSumologicCSERuleTestRead_renderedByAPI(t *testing.T) {
+	client, httpClient := newRoutingTestClient(map[string]string{
+		"sec/v1/rules/test": `{"data": {"results": [
+			{"matched": true, "signalName": "Failed login from 10.0.0.1", "summary": "", "severity": 9}
+		]}}`,
+	})
+	d := dataSourceSumologicCSERuleTest().Data(nil)
+	d.Set("expression", "metadata_deviceEventId = 'Security-4625'")
+	d.Set("name_expression", "Failed login from {{srcDevice_ip}}")
+	d.Set("severity_mapping", []interface{}{map[string]interface{}{
+		"type": "fieldValueMapping", "field": "normalizedSeverity", "default": 3,
+		"mapping": []interface{}{map[string]interface{}{"type": "gt", "from": "5", "to": 9}},
+	}})
+	d.Set("record", []interface{}{
+		map[string]interface{}{
+			"json": `{"metadata_deviceEventId": "Security-4625", "srcDevice_ip": "10.0.0.1", "normalizedSeverity": 7}`,
+		},
+	})
+
+	err := dataSourceSumologicCSERuleTestRead(d, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The severity is the one returned by the API, even though the mapping can't be evaluated locally.
+	if d.Get("results.0.signal_name") != "Failed login from 10.0.0.1" || d.Get("results.0.severity") != 9 {
+		t.Errorf("expected the signal returned by the API, got %v", d.Get("results.0"))
+	}
+	if body := httpClient.requestBodies["POST sec/v1/rules/test"]; !strings.Contains(body, `"nameExpression":"Failed login from {{srcDevice_ip}}"`) ||
+		!strings.Contains(body, `"severityMapping":{"type":"fieldValueMapping"`) {
+		t.Errorf("expected the expressions and severity mapping of the rule to be sent, got %s", body)
+	}
+}
+
+func TestAccDataSourceSumologicCSERuleTest_basic(t *testing.T) {
+	SkipCseTest(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceSumologicCSERuleTestConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sumologic_cse_rule_test.failed_login", "results.0.matched", "true"),
+					resource.TestCheckResourceAttr("data.sumologic_cse_rule_test.failed_login", "results.0.signal_name",
+						"Failed login from 10.0.0.1"),
+					resource.TestCheckResourceAttr("data.sumologic_cse_rule_test.failed_login", "results.1.matched", "false"),
+				),
+			},
+		},
+	})
+}
+
+var testDataSourceSumologicCSERuleTestConfig = `
+data "sumologic_cse_rule_test" "failed_login" {
+  expression      = "metadata_deviceEventId = 'Security-4625'"
+  name_expression = "Failed login from {{srcDevice_ip}}"
+  severity        = 4
+
+  record {
+    json = jsonencode({ metadata_deviceEventId = "Security-4625", srcDevice_ip = "10.0.0.1" })
+    expected {
+      match    = true
+      severity = 4
+    }
+  }
+  record {
+    json = jsonencode({ metadata_deviceEventId = "Security-4624", srcDevice_ip = "10.0.0.1" })
+    expected {
+      match = false
+    }
+  }
+}
+`
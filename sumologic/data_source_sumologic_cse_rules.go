@@ -0,0 +1,148 @@
+package sumologic
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceSumologicCSERules() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSumologicCSERulesRead,
+		Schema: map[string]*schema.Schema{
+			"query": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"rule_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(cseRuleTypes, false),
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"built_in": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"rule_source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSumologicCSERulesRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	search := CSERuleSearch{
+		Query:     d.Get("query").(string),
+		Name:      d.Get("name").(string),
+		Tags:      resourceToStringArray(d.Get("tags").(*schema.Set).List()),
+		RuleTypes: resourceToStringArray(d.Get("rule_types").(*schema.Set).List()),
+	}
+	if enabled, ok := d.GetOkExists("enabled"); ok {
+		enabledBool := enabled.(bool)
+		search.Enabled = &enabledBool
+	}
+	if builtIn, ok := d.GetOkExists("built_in"); ok {
+		builtInBool := builtIn.(bool)
+		search.BuiltIn = &builtInBool
+	}
+
+	rules, err := c.SearchCSERules(search)
+	if err != nil {
+		return fmt.Errorf("error retrieving CSE rules: %v", err)
+	}
+
+	ids := make([]string, 0, len(rules))
+	terraformRules := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		ids = append(ids, rule.ID)
+		terraformRules = append(terraformRules, map[string]interface{}{
+			"id":          rule.ID,
+			"name":        rule.Name,
+			"type":        rule.Type(),
+			"enabled":     rule.Enabled,
+			"rule_source": rule.RuleSource,
+			"tags":        rule.Tags,
+		})
+	}
+
+	d.Set("ids", ids)
+	d.Set("rules", terraformRules)
+	d.SetId(generateCSERulesId(ids))
+
+	return nil
+}
+
+func generateCSERulesId(ids []string) string {
+	sortedIds := append([]string{"cse_rule_ids"}, ids...)
+	sort.Strings(sortedIds)
+
+	idString := strings.Join(sortedIds, "|")
+	hash := sha256.Sum256([]byte(idString))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,95 @@
+package sumologic
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestSearchCSERules(t *testing.T) {
+	client, httpClient := newRoutingTestClient(map[string]string{
+		"sec/v1/rules": `{"data": {"total": 4, "objects": [
+			{"id": "MATCH-S00001", "name": "Credential Dumping", "ruleType": "templated match", "ruleSource": "sumo",
+				"enabled": true, "tags": ["_mitreAttackTactic:TA0006"]},
+			{"id": "FIRST-S00002", "name": "First Seen Credential Access", "ruleType": "first-seen", "ruleSource": "sumo",
+				"enabled": false, "tags": ["_mitreAttackTactic:TA0006"]},
+			{"id": "THRESHOLD-U00003", "name": "Credential Spraying", "ruleType": "threshold", "ruleSource": "user",
+				"enabled": true, "tags": ["_mitreAttackTactic:TA0006", "team:soc"]},
+			{"id": "CHAIN-S00004", "name": "Lateral Movement", "ruleType": "chain", "ruleSource": "sumo",
+				"enabled": true, "tags": ["_mitreAttackTactic:TA0008"]}
+		]}}`,
+	})
+
+	enabled := true
+	builtIn := true
+	testCases := []struct {
+		search   CSERuleSearch
+		expected []string
+	}{
+		{CSERuleSearch{}, []string{"MATCH-S00001", "FIRST-S00002", "THRESHOLD-U00003", "CHAIN-S00004"}},
+		{CSERuleSearch{Name: "credential"}, []string{"MATCH-S00001", "FIRST-S00002", "THRESHOLD-U00003"}},
+		{CSERuleSearch{Tags: []string{"_mitreAttackTactic:TA0006", "team:soc"}}, []string{"THRESHOLD-U00003"}},
+		{CSERuleSearch{RuleTypes: []string{"match", "first_seen"}}, []string{"MATCH-S00001", "FIRST-S00002"}},
+		{CSERuleSearch{Tags: []string{"_mitreAttackTactic:TA0006"}, Enabled: &enabled, BuiltIn: &builtIn},
+			[]string{"MATCH-S00001"}},
+	}
+	for _, testCase := range testCases {
+		rules, err := client.SearchCSERules(testCase.search)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, rule := range rules {
+			ids = append(ids, rule.ID)
+		}
+		if !reflect.DeepEqual(ids, testCase.expected) {
+			t.Errorf("expected rules %v for search %+v, got %v", testCase.expected, testCase.search, ids)
+		}
+	}
+
+	// The tags and the enabled state are sent in the query. Built-in rules can have any source but user,
+	// so they are only selected on the client.
+	expectedQuery := `tags:"_mitreAttackTactic:TA0006" enabled:true`
+	if query := httpClient.queries[len(httpClient.queries)-1]; query != expectedQuery {
+		t.Errorf("expected the query %s, got %s", expectedQuery, query)
+	}
+
+	custom := false
+	rules, err := client.SearchCSERules(CSERuleSearch{BuiltIn: &custom})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 1 || rules[0].ID != "THRESHOLD-U00003" {
+		t.Errorf("expected only the custom rule, got %+v", rules)
+	}
+	if query := httpClient.queries[len(httpClient.queries)-1]; query != `ruleSource:"user"` {
+		t.Errorf("expected custom rules to be selected in the query, got %s", query)
+	}
+}
+
+func TestAccDataSourceSumologicCSERules_basic(t *testing.T) {
+	SkipCseTest(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceSumologicCSERulesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sumologic_cse_rules.credential_access", "ids.0"),
+					resource.TestCheckResourceAttr("data.sumologic_cse_rules.credential_access", "rules.0.type", "match"),
+				),
+			},
+		},
+	})
+}
+
+var testDataSourceSumologicCSERulesConfig = `
+data "sumologic_cse_rules" "credential_access" {
+  tags       = ["_mitreAttackTactic:TA0006"]
+  rule_types = ["match"]
+  built_in   = true
+}
+`
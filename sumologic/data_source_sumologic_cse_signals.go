@@ -0,0 +1,240 @@
+package sumologic
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceSumologicCSESignals() *schema.Resource {
+	signalsSchema := map[string]*schema.Schema{
+		"query": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"rule_ids": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		"entity_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"entity_value": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"min_severity": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(0, 10),
+		},
+		"tags": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		"ids": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"signal_count": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"signals": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"rule_id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"severity": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"stage": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"timestamp": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"entity_type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"entity_value": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"prototype": {
+						Type:     schema.TypeBool,
+						Computed: true,
+					},
+					"tags": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+	addCSESearchTimeRangeSchema(signalsSchema)
+
+	return &schema.Resource{
+		Read:   dataSourceSumologicCSESignalsRead,
+		Schema: signalsSchema,
+	}
+}
+
+// addCSESearchTimeRangeSchema adds the arguments selecting the time range of a search of the insights or signals.
+func addCSESearchTimeRangeSchema(s map[string]*schema.Schema) {
+	s["from"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ValidateFunc:  validation.IsRFC3339Time,
+		ConflictsWith: []string{"lookback"},
+	}
+	s["to"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.IsRFC3339Time,
+	}
+	s["lookback"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ValidateFunc: func(v interface{}, k string) (warnings []string, errors []error) {
+			if _, err := parseCSELookback(v.(string)); err != nil {
+				errors = append(errors, fmt.Errorf("%q: %v", k, err))
+			}
+			return
+		},
+		ConflictsWith: []string{"from"},
+	}
+}
+
+// cseSearchDefaultLookback is the lookback of a search of the insights or signals when neither from nor
+// lookback is set, so that a search doesn't go through all of them.
+const cseSearchDefaultLookback = 7 * 24 * time.Hour
+
+// expandCSESearchTimeRange returns the time range of a search of the insights or signals. A lookback is
+// relative to now, or to the end of the range when to is set.
+func expandCSESearchTimeRange(d *schema.ResourceData, now time.Time) (time.Time, time.Time) {
+	var from, to time.Time
+	if v, ok := d.GetOk("to"); ok {
+		to, _ = time.Parse(time.RFC3339, v.(string))
+	}
+	if v, ok := d.GetOk("from"); ok {
+		from, _ = time.Parse(time.RFC3339, v.(string))
+		return from, to
+	}
+
+	lookback := cseSearchDefaultLookback
+	if v, ok := d.GetOk("lookback"); ok {
+		lookback, _ = parseCSELookback(v.(string))
+	}
+	end := now
+	if !to.IsZero() {
+		end = to
+	}
+	return end.Add(-lookback), to
+}
+
+// parseCSELookback parses a duration such as "168h" or, in days, "7d".
+func parseCSELookback(lookback string) (time.Duration, error) {
+	var duration time.Duration
+	if days := strings.TrimSuffix(lookback, "d"); days != lookback {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid lookback %s", lookback)
+		}
+		duration = time.Duration(n) * 24 * time.Hour
+	} else {
+		var err error
+		duration, err = time.ParseDuration(lookback)
+		if err != nil {
+			return 0, fmt.Errorf("invalid lookback %s", lookback)
+		}
+	}
+	if duration <= 0 {
+		return 0, fmt.Errorf("lookback %s is not positive", lookback)
+	}
+	return duration, nil
+}
+
+func dataSourceSumologicCSESignalsRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	from, to := expandCSESearchTimeRange(d, time.Now())
+	search := CSESignalSearch{
+		Query:       d.Get("query").(string),
+		From:        from,
+		To:          to,
+		RuleIDs:     resourceToStringArray(d.Get("rule_ids").(*schema.Set).List()),
+		EntityType:  d.Get("entity_type").(string),
+		EntityValue: d.Get("entity_value").(string),
+		MinSeverity: d.Get("min_severity").(int),
+		Tags:        resourceToStringArray(d.Get("tags").(*schema.Set).List()),
+	}
+
+	signals, err := c.SearchCSESignals(search)
+	if err != nil {
+		return fmt.Errorf("error retrieving CSE signals: %v", err)
+	}
+
+	ids := make([]string, 0, len(signals))
+	terraformSignals := make([]map[string]interface{}, 0, len(signals))
+	for _, signal := range signals {
+		ids = append(ids, signal.ID)
+		terraformSignals = append(terraformSignals, map[string]interface{}{
+			"id":           signal.ID,
+			"name":         signal.Name,
+			"rule_id":      signal.RuleID,
+			"severity":     signal.Severity,
+			"stage":        signal.Stage,
+			"timestamp":    signal.Timestamp,
+			"entity_type":  signal.Entity.EntityType,
+			"entity_value": signal.Entity.Value,
+			"prototype":    signal.Prototype,
+			"tags":         signal.Tags,
+		})
+	}
+
+	d.Set("ids", ids)
+	d.Set("signal_count", len(ids))
+	d.Set("signals", terraformSignals)
+	d.SetId(generateCSERulesId(ids))
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package sumologic
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDataSourceSumologicCSESignalsRead(t *testing.T) {
+	client, httpClient := newRoutingTestClient(map[string]string{
+		"sec/v1/signals": `{"data": {"total": 4, "objects": [
+			{"id": "1", "name": "Admin login", "ruleId": "MATCH-U00001", "severity": 5, "timestamp": "2024-03-04T10:00:00",
+				"entity": {"entityType": "_username", "value": "jdoe"}, "isPrototype": true},
+			{"id": "2", "name": "Admin login", "ruleId": "MATCH-U00001", "severity": 2, "timestamp": "2024-03-05T10:00:00",
+				"entity": {"entityType": "_username", "value": "asmith"}, "isPrototype": true},
+			{"id": "3", "name": "Port scan", "ruleId": "THRESHOLD-S00002", "severity": 6, "timestamp": "2024-03-05T11:00:00Z",
+				"entity": {"entityType": "_ip", "value": "10.0.0.1"}},
+			{"id": "4", "name": "Admin login", "ruleId": "MATCH-U00001", "severity": 5, "timestamp": "2024-02-01T10:00:00Z",
+				"entity": {"entityType": "_username", "value": "jdoe"}, "isPrototype": true}
+		]}}`,
+	})
+
+	d := dataSourceSumologicCSESignals().Data(nil)
+	d.Set("query", `stage:"Initial Access"`)
+	d.Set("from", "2024-03-01T00:00:00Z")
+	d.Set("to", "2024-03-08T00:00:00Z")
+	d.Set("rule_ids", []interface{}{"MATCH-U00001"})
+	d.Set("min_severity", 3)
+
+	err := dataSourceSumologicCSESignalsRead(d, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedQuery := `stage:"Initial Access" timestamp:>=2024-03-01T00:00:00Z timestamp:<2024-03-08T00:00:00Z ` +
+		`ruleId:"MATCH-U00001" severity:>=3`
+	if len(httpClient.queries) != 1 || httpClient.queries[0] != expectedQuery {
+		t.Errorf("expected the query %s, got %v", expectedQuery, httpClient.queries)
+	}
+	if ids := d.Get("ids"); !reflect.DeepEqual(ids, []interface{}{"1"}) {
+		t.Errorf("expected ids [1], got %v", ids)
+	}
+	if count := d.Get("signal_count"); count != 1 {
+		t.Errorf("expected 1 signal, got %v", count)
+	}
+	if !d.Get("signals.0.prototype").(bool) || d.Get("signals.0.entity_value") != "jdoe" {
+		t.Errorf("unexpected signal %v", d.Get("signals.0"))
+	}
+}
+
+func TestExpandCSESearchTimeRange(t *testing.T) {
+	now := time.Date(2024, 3, 8, 12, 0, 0, 0, time.UTC)
+
+	d := dataSourceSumologicCSESignals().Data(nil)
+	from, to := expandCSESearchTimeRange(d, now)
+	if !from.Equal(now.Add(-cseSearchDefaultLookback)) || !to.IsZero() {
+		t.Errorf("expected the default lookback, got [%v, %v)", from, to)
+	}
+
+	d.Set("lookback", "1d")
+	from, to = expandCSESearchTimeRange(d, now)
+	if !from.Equal(now.AddDate(0, 0, -1)) || !to.IsZero() {
+		t.Errorf("expected the last day, got [%v, %v)", from, to)
+	}
+
+	d.Set("to", "2024-03-01T00:00:00Z")
+	d.Set("lookback", "12h")
+	from, to = expandCSESearchTimeRange(d, now)
+	if !from.Equal(time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC)) || !to.Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the 12 hours before to, got [%v, %v)", from, to)
+	}
+}
+
+func TestCSESignalSearch_query(t *testing.T) {
+	search := CSESignalSearch{
+		RuleIDs:     []string{"MATCH-U00001", "THRESHOLD-S00002"},
+		EntityType:  "_username",
+		EntityValue: "jdoe",
+		Tags:        []string{"team:soc"},
+	}
+	expectedQuery := `ruleId:["MATCH-U00001","THRESHOLD-S00002"] entity.entityType:"_username" entity.value:"jdoe" tags:"team:soc"`
+	if query := search.query().params().Get("q"); query != expectedQuery {
+		t.Errorf("expected the query %s, got %s", expectedQuery, query)
+	}
+}
+
+func TestParseCSELookback(t *testing.T) {
+	for lookback, expected := range map[string]time.Duration{
+		"7d":   7 * 24 * time.Hour,
+		"168h": 168 * time.Hour,
+		"90m":  90 * time.Minute,
+	} {
+		duration, err := parseCSELookback(lookback)
+		if err != nil || duration != expected {
+			t.Errorf("expected %s to be %v, got %v (%v)", lookback, expected, duration, err)
+		}
+	}
+	for _, lookback := range []string{"", "d", "7", "-1d", "0h", "1w"} {
+		if _, err := parseCSELookback(lookback); err == nil {
+			t.Errorf("expected %q to be invalid", lookback)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func dataSourceSumologicDashboardExport() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSumologicDashboardExportRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"resource_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "dashboard",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`),
+					"must be a valid terraform resource name"),
+			},
+			"hcl": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSumologicDashboardExportRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	id := d.Get("id").(string)
+	dashboard, err := c.GetDashboard(id)
+	if err != nil {
+		return err
+	}
+	if dashboard == nil {
+		return fmt.Errorf("dashboard with id %s not found", id)
+	}
+
+	hcl, err := getDashboardHcl(dashboard, d.Get("resource_name").(string))
+	if err != nil {
+		return err
+	}
+	dashboardJson, err := json.Marshal(dashboard)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(dashboard.ID)
+	d.Set("hcl", hcl)
+	d.Set("json", string(dashboardJson))
+	return nil
+}
+
+// getDashboardHcl renders the dashboard as a sumologic_dashboard resource, converting it the same way
+// as when the resource is read.
+func getDashboardHcl(dashboard *Dashboard, resourceName string) (string, error) {
+	dashboardResource := resourceSumologicDashboard()
+	d := dashboardResource.Data(nil)
+	if err := setDashboard(d, dashboard); err != nil {
+		return "", err
+	}
+
+	file := hclwrite.NewEmptyFile()
+	block := file.Body().AppendNewBlock("resource", []string{"sumologic_dashboard", resourceName})
+	values := make(map[string]interface{})
+	for key := range dashboardResource.Schema {
+		values[key] = d.Get(key)
+	}
+	writeHclBody(block.Body(), dashboardResource.Schema, values)
+
+	return string(hclwrite.Format(file.Bytes())), nil
+}
+
+// writeHclBody writes the configurable values to body, attributes first and then nested blocks.
+// Optional values that are empty or equal to their default are omitted.
+func writeHclBody(body *hclwrite.Body, schemaMap map[string]*schema.Schema, values map[string]interface{}) {
+	keys := make([]string, 0, len(schemaMap))
+	for key := range schemaMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var blockKeys []string
+	for _, key := range keys {
+		s := schemaMap[key]
+		if !s.Required && !s.Optional {
+			continue
+		}
+		if _, ok := s.Elem.(*schema.Resource); ok {
+			blockKeys = append(blockKeys, key)
+			continue
+		}
+		if value, ok := getHclValue(s, values[key]); ok {
+			body.SetAttributeValue(key, value)
+		}
+	}
+
+	for _, key := range blockKeys {
+		s := schemaMap[key]
+		elems := values[key]
+		if set, ok := elems.(*schema.Set); ok {
+			elems = set.List()
+		}
+		list, _ := elems.([]interface{})
+		for _, elem := range list {
+			elemValues, _ := elem.(map[string]interface{})
+			block := body.AppendNewBlock(key, nil)
+			writeHclBody(block.Body(), s.Elem.(*schema.Resource).Schema, elemValues)
+		}
+	}
+}
+
+func getHclValue(s *schema.Schema, value interface{}) (cty.Value, bool) {
+	switch s.Type {
+	case schema.TypeString, schema.TypeInt, schema.TypeFloat, schema.TypeBool:
+		if value == nil {
+			return cty.NilVal, false
+		}
+		if !s.Required {
+			if s.Default != nil && value == s.Default {
+				return cty.NilVal, false
+			}
+			if s.Default == nil && isZeroHclValue(value) {
+				return cty.NilVal, false
+			}
+		}
+		return getHclPrimitive(value), true
+	case schema.TypeList, schema.TypeSet:
+		if set, ok := value.(*schema.Set); ok {
+			value = set.List()
+		}
+		list, _ := value.([]interface{})
+		if len(list) == 0 {
+			return cty.NilVal, false
+		}
+		elems := make([]cty.Value, len(list))
+		for i, elem := range list {
+			elems[i] = getHclPrimitive(elem)
+		}
+		return cty.TupleVal(elems), true
+	case schema.TypeMap:
+		m, _ := value.(map[string]interface{})
+		if len(m) == 0 {
+			return cty.NilVal, false
+		}
+		elems := make(map[string]cty.Value, len(m))
+		for k, elem := range m {
+			elems[k] = getHclPrimitive(elem)
+		}
+		return cty.ObjectVal(elems), true
+	}
+	return cty.NilVal, false
+}
+
+func getHclPrimitive(value interface{}) cty.Value {
+	switch v := value.(type) {
+	case string:
+		return cty.StringVal(v)
+	case int:
+		return cty.NumberIntVal(int64(v))
+	case float64:
+		return cty.NumberFloatVal(v)
+	case bool:
+		return cty.BoolVal(v)
+	}
+	return cty.StringVal(fmt.Sprintf("%v", value))
+}
+
+func isZeroHclValue(value interface{}) bool {
+	switch v := value.(type) {
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	}
+	return false
+}
@@ -0,0 +1,224 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const exampleDashboardJson = `{
+	"id": "7Wy8r5Ew0IYHO0cQzAUyAXwDrBLsJ0EcEf0I4kqyCjcadgtwXUxPdk6d2gYw",
+	"title": "Api Health",
+	"description": "",
+	"folderId": "0000000000000001",
+	"topologyLabelMap": {"data": {}},
+	"domain": "app",
+	"refreshInterval": 0,
+	"timeRange": {
+		"type": "BeginBoundedTimeRange",
+		"from": {"type": "RelativeTimeRangeBoundary", "relativeTime": "-15m"},
+		"to": null
+	},
+	"panels": [
+		{
+			"id": "panel-id-1",
+			"key": "text-panel-01",
+			"title": "Api Health",
+			"visualSettings": "",
+			"keepVisualSettingsConsistentWithParent": true,
+			"panelType": "TextPanel",
+			"text": "Errors in ${env}"
+		},
+		{
+			"id": "panel-id-2",
+			"key": "search-panel-01",
+			"title": "Api Errors",
+			"visualSettings": "{\"general\":{\"type\":\"line\"}}",
+			"keepVisualSettingsConsistentWithParent": false,
+			"panelType": "SumoSearchPanel",
+			"description": "",
+			"queries": [
+				{
+					"queryString": "_sourceCategory=api error | count",
+					"queryType": "Logs",
+					"queryKey": "A",
+					"parseMode": "Auto",
+					"timeSource": "Message",
+					"outputCardinalityLimit": 1000
+				}
+			],
+			"timeRange": null,
+			"linkedDashboards": []
+		}
+	],
+	"layout": {
+		"layoutType": "Grid",
+		"layoutStructures": [
+			{"key": "text-panel-01", "structure": "{\"height\":5,\"width\":24,\"x\":0,\"y\":0}"}
+		]
+	},
+	"variables": [
+		{
+			"id": "variable-id-1",
+			"name": "env",
+			"displayName": "env",
+			"defaultValue": "prod",
+			"sourceDefinition": {"variableSourceType": "CsvVariableSourceDefinition", "values": "prod,dev"},
+			"allowMultiSelect": false,
+			"includeAllOption": true,
+			"hideFromUI": false
+		}
+	],
+	"theme": "Light",
+	"coloringRules": []
+}`
+
+func TestGetDashboardHcl(t *testing.T) {
+	var dashboard Dashboard
+	if err := json.Unmarshal([]byte(exampleDashboardJson), &dashboard); err != nil {
+		t.Fatal(err)
+	}
+
+	hcl, err := getDashboardHcl(&dashboard, "api_health")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedSnippets := []string{
+		`resource "sumologic_dashboard" "api_health" {`,
+		`title     = "Api Health"`,
+		`folder_id = "0000000000000001"`,
+		`relative_time = "-15m"`,
+		`text  = "Errors in $${env}"`,
+		`keep_visual_settings_consistent_with_parent = false`,
+		`visual_settings                             = "{\"general\":{\"type\":\"line\"}}"`,
+		`query_string = "_sourceCategory=api error | count"`,
+		`key       = "text-panel-01"`,
+		`values = "prod,dev"`,
+	}
+	for _, snippet := range expectedSnippets {
+		if !strings.Contains(hcl, snippet) {
+			t.Errorf("expected rendered HCL to contain %s, got\n%s", snippet, hcl)
+		}
+	}
+	// Defaults and values that are not configurable are omitted.
+	for _, snippet := range []string{`theme`, `parse_mode`, `panel-id-`, `variable-id-`, `refresh_interval`} {
+		if strings.Contains(hcl, snippet) {
+			t.Errorf("expected rendered HCL not to contain %s, got\n%s", snippet, hcl)
+		}
+	}
+}
+
+func TestExpandDashboardJson(t *testing.T) {
+	definition, err := expandDashboardJson(exampleDashboardJson)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(definition.Panels) != 2 {
+		t.Fatalf("expected 2 panels, got %+v", definition.Panels)
+	}
+	textPanel, ok := definition.Panels[0].(TextPanel)
+	if !ok || textPanel.Key != "text-panel-01" || textPanel.Text != "Errors in ${env}" || textPanel.Id != "" {
+		t.Errorf("unexpected text panel %+v", definition.Panels[0])
+	}
+	searchPanel, ok := definition.Panels[1].(SumoSearchPanel)
+	if !ok || len(searchPanel.Queries) != 1 || searchPanel.Queries[0].QueryKey != "A" {
+		t.Errorf("unexpected search panel %+v", definition.Panels[1])
+	}
+	if layout, ok := definition.Layout.(GridLayout); !ok || len(layout.LayoutStructures) != 1 {
+		t.Errorf("unexpected layout %+v", definition.Layout)
+	}
+	if len(definition.Variables) != 1 || definition.Variables[0].Name != "env" || definition.Variables[0].Id != "" {
+		t.Errorf("unexpected variables %+v", definition.Variables)
+	}
+
+	// The ids of panels and variables are not part of the definition, so they don't cause a diff.
+	reformatted := strings.ReplaceAll(strings.ReplaceAll(exampleDashboardJson, "panel-id-", "other-panel-id-"), "\t", "  ")
+	if !suppressEquivalentDashboardJsonDiff("from_json", exampleDashboardJson, reformatted, nil) {
+		t.Errorf("expected equivalent dashboard JSON not to cause a diff")
+	}
+	changed := strings.Replace(exampleDashboardJson, `"queryKey": "A"`, `"queryKey": "B"`, 1)
+	if suppressEquivalentDashboardJsonDiff("from_json", exampleDashboardJson, changed, nil) {
+		t.Errorf("expected changed dashboard JSON to cause a diff")
+	}
+
+	for value, expectedError := range map[string]string{
+		`{"panels": [`:                    "invalid from_json",
+		`{"panels": ["search-panel-01"]}`: "panel 0 must be an object, got a string",
+		`{"panels": [{"panelType": "SumoSearchPanel", "key": "search-panel-01", "queries": {}}]}`: "invalid panel search-panel-01: queries must be a list, got an object",
+		`{"panels": [{"panelType": "SumoSearchPanel", "key": "search-panel-01", "queries": [
+			{"queryKey": "A", "metricsQueryData": {"filters": [1]}}]}]}`: "invalid query A: metricsQueryData.filters[0] must be an object, got a number",
+		`{"layout": {"layoutType": "Grid", "layoutStructures": "panel-01"}}`: "layout.layoutStructures must be a list, got a string",
+		`{"variables": [{"name": "host", "sourceDefinition": null}]}`:        "sourceDefinition of variable host must be an object, got null",
+	} {
+		if _, err := expandDashboardJson(value); err == nil || !strings.Contains(err.Error(), expectedError) {
+			t.Errorf("expected error for %s to contain %q, got %v", value, expectedError, err)
+		}
+	}
+}
+
+func TestAccSumologicDashboardExport_basic(t *testing.T) {
+	title := "terraform_test_dashboard_export_" + acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSumologicDashboardExportConfig(title),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sumologic_dashboard_export.test", "id",
+						"sumologic_dashboard.source", "id"),
+					resource.TestCheckResourceAttrSet("data.sumologic_dashboard_export.test", "hcl"),
+					resource.TestCheckResourceAttr("sumologic_dashboard.copy", "title", title+"_copy"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSumologicDashboardExportConfig(title string) string {
+	return fmt.Sprintf(`
+resource "sumologic_dashboard" "source" {
+	title = "%s"
+	time_range {
+		begin_bounded_time_range {
+			from {
+				relative_time_range {
+					relative_time = "-15m"
+				}
+			}
+		}
+	}
+	panel {
+		text_panel {
+			key  = "text-panel-01"
+			text = "Exported panel"
+		}
+	}
+}
+
+data "sumologic_dashboard_export" "test" {
+	id            = sumologic_dashboard.source.id
+	resource_name = "exported"
+}
+
+resource "sumologic_dashboard" "copy" {
+	title     = "%s_copy"
+	from_json = data.sumologic_dashboard_export.test.json
+	time_range {
+		begin_bounded_time_range {
+			from {
+				relative_time_range {
+					relative_time = "-15m"
+				}
+			}
+		}
+	}
+}
+`, title, title)
+}
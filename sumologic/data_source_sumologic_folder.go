@@ -1,9 +1,7 @@
 package sumologic
 
 import (
-	"encoding/json"
 	"fmt"
-	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -44,23 +42,19 @@ func dataSourceSumologicFolderRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func (s *Client) GetFolderByPath(path string) (*Folder, error) {
-	data, err := s.Get(fmt.Sprintf("v2/content/path?path=%s", url.QueryEscape(path)))
+	content, err := s.GetContentByPath(path)
 	if err != nil {
 		return nil, err
 	}
-
-	if data == nil {
-		return nil, fmt.Errorf("folder with path '%s' does not exist", path)
-	}
-
-	var folder Folder
-	err = json.Unmarshal(data, &folder)
-	if err != nil {
-		return nil, err
-	}
-	if len(folder.ID) == 0 {
+	if content == nil {
 		return nil, fmt.Errorf("folder with path '%s' does not exist", path)
 	}
 
-	return &folder, nil
+	return &Folder{
+		ID:          content.ID,
+		Name:        content.Name,
+		Description: content.Description,
+		ParentId:    content.ParentId,
+		CreatedBy:   content.CreatedBy,
+	}, nil
 }
@@ -0,0 +1,147 @@
+package sumologic
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSumologicSLO() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSumologicSLORead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "path"},
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parent_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"signal_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"application": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"evaluation_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compliance": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compliance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"timezone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_from": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSumologicSLORead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	var slo *SLOLibrarySLO
+	var err error
+	path := d.Get("path").(string)
+	if rid, ok := d.GetOk("id"); ok {
+		id := rid.(string)
+		slo, err = c.SLORead(id)
+		if err != nil {
+			return fmt.Errorf("SLO with id %v not found: %v", id, err)
+		}
+		if slo == nil {
+			return fmt.Errorf("SLO with id %v not found", id)
+		}
+		path, err = c.GetSLOPath(id)
+		if err != nil {
+			return err
+		}
+	} else if path != "" {
+		slo, err = c.GetSLOByPath(path)
+		if err != nil {
+			return err
+		}
+		if slo == nil || slo.ID == "" {
+			return fmt.Errorf("SLO with path '%s' does not exist", path)
+		}
+	} else {
+		return errors.New("please specify either id or path")
+	}
+
+	if slo.ContentType != "" && slo.ContentType != sloContentTypeString {
+		return fmt.Errorf("content with id %s is a %s, not an SLO", slo.ID, slo.ContentType)
+	}
+
+	d.SetId(slo.ID)
+	d.Set("path", path)
+	d.Set("name", slo.Name)
+	d.Set("description", slo.Description)
+	d.Set("parent_id", slo.ParentID)
+	d.Set("signal_type", slo.SignalType)
+	d.Set("service", slo.Service)
+	d.Set("application", slo.Application)
+	d.Set("evaluation_type", slo.Indicator.EvaluationType)
+	d.Set("tags", slo.Tags)
+
+	flatCompliance, err := flattenSLOCompliance(slo.Compliance)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("compliance", flatCompliance); err != nil {
+		return fmt.Errorf("error setting compliance fields for datasource %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
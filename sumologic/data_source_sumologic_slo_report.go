@@ -0,0 +1,134 @@
+package sumologic
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceSumologicSLOReport() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSumologicSLOReportRead,
+
+		Schema: map[string]*schema.Schema{
+			"slo_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"compliance_window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateSLOComplianceSize,
+			},
+			"slos": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"compliance_window": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sli": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"error_budget_remaining": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"burn_rate": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"min_error_budget_remaining": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSumologicSLOReportRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	rawIds := d.Get("slo_ids").([]interface{})
+	ids := make([]string, len(rawIds))
+	for i := range rawIds {
+		ids[i] = rawIds[i].(string)
+	}
+	complianceWindow := d.Get("compliance_window").(string)
+
+	reports, err := c.GetSLOReport(ids, complianceWindow)
+	if err != nil {
+		return fmt.Errorf("error retrieving SLO report: %v", err)
+	}
+
+	reportsById := make(map[string]SLOReport, len(reports))
+	for _, report := range reports {
+		reportsById[report.SloID] = report
+	}
+
+	// Keep the order of slo_ids so that results can be indexed the same way as the input.
+	slos := make([]map[string]interface{}, 0, len(ids))
+	minErrorBudgetRemaining := math.Inf(1)
+	for _, id := range ids {
+		report, ok := reportsById[id]
+		if !ok {
+			return fmt.Errorf("no SLO report returned for SLO with id %s", id)
+		}
+		slos = append(slos, map[string]interface{}{
+			"id":                     report.SloID,
+			"name":                   report.Name,
+			"status":                 report.Status,
+			"compliance_window":      report.ComplianceWindow,
+			"sli":                    report.SLI,
+			"error_budget_remaining": report.ErrorBudgetRemaining,
+			"burn_rate":              report.BurnRate,
+		})
+		minErrorBudgetRemaining = math.Min(minErrorBudgetRemaining, report.ErrorBudgetRemaining)
+	}
+
+	if err := d.Set("slos", slos); err != nil {
+		return fmt.Errorf("error setting slos for datasource %s: %s", d.Id(), err)
+	}
+	d.Set("min_error_budget_remaining", minErrorBudgetRemaining)
+	d.SetId(generateSLOReportId(ids, complianceWindow))
+
+	return nil
+}
+
+func generateSLOReportId(ids []string, complianceWindow string) string {
+	sortedIds := append([]string{"slo_report", complianceWindow}, ids...)
+	sort.Strings(sortedIds[2:])
+
+	idString := strings.Join(sortedIds, "|")
+	hash := sha256.Sum256([]byte(idString))
+	return hex.EncodeToString(hash[:])
+}
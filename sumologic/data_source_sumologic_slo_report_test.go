@@ -0,0 +1,87 @@
+package sumologic
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceSumologicSLOReport_read(t *testing.T) {
+	body := []byte(`{
+		"data": [
+			{"sloId": "0000000000000002", "name": "checkout latency", "status": "Computed",
+			 "complianceWindow": "7d", "sli": 99.2, "errorBudgetRemaining": -60, "burnRate": 1.6},
+			{"sloId": "0000000000000001", "name": "checkout availability", "status": "Computed",
+			 "complianceWindow": "7d", "sli": 99.95, "errorBudgetRemaining": 50, "burnRate": 0.5}
+		]
+	}`)
+	client := newTestClient(&http.Response{
+		Status:     http.StatusText(200),
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(bytes.NewReader(body)),
+	})
+	d := schema.TestResourceDataRaw(t, dataSourceSumologicSLOReport().Schema, map[string]interface{}{
+		"slo_ids": []interface{}{"0000000000000001", "0000000000000002"},
+	})
+
+	if err := dataSourceSumologicSLOReportRead(d, client); err != nil {
+		t.Fatalf("Expected read to succeed, received: %s", err)
+	}
+	if got := d.Get("slos.0.id").(string); got != "0000000000000001" {
+		t.Errorf("Expected results in the order of slo_ids, got %s first", got)
+	}
+	if got := d.Get("slos.0.error_budget_remaining").(float64); got != 50 {
+		t.Errorf("Expected error budget remaining of 50, got %v", got)
+	}
+	if got := d.Get("slos.1.burn_rate").(float64); got != 1.6 {
+		t.Errorf("Expected burn rate of 1.6, got %v", got)
+	}
+	if got := d.Get("min_error_budget_remaining").(float64); got != -60 {
+		t.Errorf("Expected min error budget remaining of -60, got %v", got)
+	}
+}
+
+func TestDataSourceSumologicSLOReport_missingSlo(t *testing.T) {
+	client := newTestClient(&http.Response{
+		Status:     http.StatusText(200),
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"data": []}`))),
+	})
+	d := schema.TestResourceDataRaw(t, dataSourceSumologicSLOReport().Schema, map[string]interface{}{
+		"slo_ids": []interface{}{"0000000000000001"},
+	})
+
+	err := dataSourceSumologicSLOReportRead(d, client)
+	if err == nil || !strings.Contains(err.Error(), "no SLO report returned for SLO with id 0000000000000001") {
+		t.Errorf("Expected missing SLO error, got %v", err)
+	}
+}
+
+func TestAccDataSourceSumologicSLOReport_basic(t *testing.T) {
+	testName := "terraform_test_slo_" + acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: exampleLogsWindowThresholdSlo(testName) + `
+data "sumologic_slo_report" "test" {
+  slo_ids           = [sumologic_slo.test.id]
+  compliance_window = "1d"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sumologic_slo_report.test", "slos.0.id", "sumologic_slo.test", "id"),
+					resource.TestCheckResourceAttrSet("data.sumologic_slo_report.test", "slos.0.status"),
+					resource.TestCheckResourceAttrSet("data.sumologic_slo_report.test", "min_error_budget_remaining"),
+				),
+			},
+		},
+	})
+}
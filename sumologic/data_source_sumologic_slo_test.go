@@ -0,0 +1,71 @@
+package sumologic
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSumologicSLO_basic(t *testing.T) {
+	testName := "terraform_test_slo_" + acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSumologicSLOConfig(testName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceSLOCheck("data.sumologic_slo.by_id", "sumologic_slo.test"),
+					testAccDataSourceSLOCheck("data.sumologic_slo.by_path", "sumologic_slo.test"),
+					resource.TestCheckResourceAttr("data.sumologic_slo.by_id", "path",
+						fmt.Sprintf("/Slo/slo-tf-test-folder/%s", testName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceSumologicSLO_slo_does_not_exist(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "sumologic_slo" "test" {
+						path = "/Slo/Terraform Test/DoesNotExist"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`SLO with path '/Slo/Terraform Test/DoesNotExist' does not exist`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSLOCheck(name, reference string) resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttrSet(name, "id"),
+		resource.TestCheckResourceAttrPair(name, "id", reference, "id"),
+		resource.TestCheckResourceAttrPair(name, "name", reference, "name"),
+		resource.TestCheckResourceAttrPair(name, "parent_id", reference, "parent_id"),
+		resource.TestCheckResourceAttrPair(name, "signal_type", reference, "signal_type"),
+		resource.TestCheckResourceAttrPair(name, "compliance.0.target", reference, "compliance.0.target"),
+		resource.TestCheckResourceAttrPair(name, "compliance.0.size", reference, "compliance.0.size"),
+		resource.TestCheckResourceAttr(name, "evaluation_type", "Window"),
+	)
+}
+
+func testAccDataSourceSumologicSLOConfig(testName string) string {
+	return exampleLogsWindowThresholdSlo(testName) + `
+data "sumologic_slo" "by_id" {
+  id = sumologic_slo.test.id
+}
+
+data "sumologic_slo" "by_path" {
+  path = data.sumologic_slo.by_id.path
+}
+`
+}
@@ -0,0 +1,455 @@
+package sumologic
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Parsing and expansion of the RFC 5545 recurrence rules used by muting schedules. Only the parts of the
+// RFC that can be configured for a muting schedule are accepted, every other part is rejected explicitly
+// so that plan-time validation and the occurrence preview never disagree with the server.
+
+const rruleDateTimeLayout = "20060102T150405"
+
+var rruleWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+var rruleFrequencies = []string{"HOURLY", "DAILY", "WEEKLY", "MONTHLY", "YEARLY"}
+
+// valid RFC 5545 rule parts and frequencies that cannot be used in a muting schedule
+var (
+	rruleUnsupportedParts       = []string{"BYSECOND", "BYYEARDAY", "BYWEEKNO", "BYSETPOS"}
+	rruleUnsupportedFrequencies = []string{"SECONDLY", "MINUTELY"}
+)
+
+var rruleByDayRegex = regexp.MustCompile(`^([+-]?\d{1,2})?(SU|MO|TU|WE|TH|FR|SA)$`)
+
+type rruleWeekday struct {
+	N       int
+	Weekday time.Weekday
+}
+
+type recurrenceRule struct {
+	Freq     string
+	Interval int
+	Count    int
+	// UNTIL as given in the rule, Until is only resolved once the timezone of the schedule is known
+	UntilValue string
+	Until      *time.Time
+	ByDay      []rruleWeekday
+	ByMonthDay []int
+	ByMonth    []int
+	ByHour     []int
+	ByMinute   []int
+	Wkst       time.Weekday
+	// DTSTART given as part of the rule, if any
+	DtStart     string
+	DtStartTzid string
+}
+
+// parseRecurrenceRule parses either a bare rule ("FREQ=DAILY;BYHOUR=9") or the multi-line form
+// that also carries the start ("DTSTART;TZID=Europe/Berlin:20240101T090000\nRRULE:FREQ=DAILY").
+func parseRecurrenceRule(value string) (*recurrenceRule, error) {
+	rule := &recurrenceRule{Interval: 1, Wkst: time.Monday}
+	var rulePart string
+
+	for _, line := range strings.FieldsFunc(value, func(r rune) bool { return r == '\n' || r == '\r' }) {
+		line = strings.TrimSpace(line)
+		upper := strings.ToUpper(line)
+		switch {
+		case line == "":
+		case strings.HasPrefix(upper, "DTSTART"):
+			if err := rule.parseDtStart(line); err != nil {
+				return nil, err
+			}
+		case strings.HasPrefix(upper, "RRULE:"):
+			rulePart = line[len("RRULE:"):]
+		case !strings.Contains(line, ":"):
+			rulePart = line
+		default:
+			return nil, fmt.Errorf("unexpected line %q in rrule", line)
+		}
+	}
+
+	if rulePart == "" {
+		return nil, fmt.Errorf("rrule must contain a FREQ part")
+	}
+
+	seen := map[string]bool{}
+	for _, part := range strings.Split(rulePart, ";") {
+		if part == "" {
+			continue
+		}
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return nil, fmt.Errorf("invalid rrule part %q, expected NAME=VALUE", part)
+		}
+		name, val := strings.ToUpper(kv[0]), strings.ToUpper(kv[1])
+		if seen[name] {
+			return nil, fmt.Errorf("rrule part %s is specified more than once", name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			if contains(rruleUnsupportedFrequencies, val) {
+				return nil, fmt.Errorf("FREQ=%s is not supported for muting schedules, must be one of %s", val, strings.Join(rruleFrequencies, ", "))
+			}
+			if !contains(rruleFrequencies, val) {
+				return nil, fmt.Errorf("invalid FREQ %q, must be one of %s", val, strings.Join(rruleFrequencies, ", "))
+			}
+			rule.Freq = val
+		case "INTERVAL":
+			rule.Interval, err = strconv.Atoi(val)
+			if err != nil || rule.Interval < 1 {
+				return nil, fmt.Errorf("invalid INTERVAL %q, must be a positive integer", val)
+			}
+		case "COUNT":
+			rule.Count, err = strconv.Atoi(val)
+			if err != nil || rule.Count < 1 {
+				return nil, fmt.Errorf("invalid COUNT %q, must be a positive integer", val)
+			}
+		case "UNTIL":
+			if _, err := parseRRuleUntil(val, time.UTC); err != nil {
+				return nil, err
+			}
+			rule.UntilValue = val
+		case "BYDAY":
+			for _, day := range strings.Split(val, ",") {
+				match := rruleByDayRegex.FindStringSubmatch(day)
+				if match == nil {
+					return nil, fmt.Errorf("invalid BYDAY value %q, expected an optional ordinal followed by one of SU, MO, TU, WE, TH, FR, SA", day)
+				}
+				weekday := rruleWeekday{Weekday: rruleWeekdays[match[2]]}
+				if match[1] != "" {
+					weekday.N, _ = strconv.Atoi(match[1])
+					if weekday.N == 0 || weekday.N > 53 || weekday.N < -53 {
+						return nil, fmt.Errorf("invalid BYDAY value %q, ordinal must be between -53 and 53 and not 0", day)
+					}
+				}
+				rule.ByDay = append(rule.ByDay, weekday)
+			}
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseRRuleIntList(name, val, -31, 31, false)
+		case "BYMONTH":
+			rule.ByMonth, err = parseRRuleIntList(name, val, 1, 12, true)
+		case "BYHOUR":
+			rule.ByHour, err = parseRRuleIntList(name, val, 0, 23, true)
+		case "BYMINUTE":
+			rule.ByMinute, err = parseRRuleIntList(name, val, 0, 59, true)
+		case "WKST":
+			weekday, ok := rruleWeekdays[val]
+			if !ok {
+				return nil, fmt.Errorf("invalid WKST %q, must be one of SU, MO, TU, WE, TH, FR, SA", val)
+			}
+			rule.Wkst = weekday
+		default:
+			if contains(rruleUnsupportedParts, name) {
+				return nil, fmt.Errorf("rrule part %s is not supported for muting schedules", name)
+			}
+			return nil, fmt.Errorf("unknown rrule part %q", name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if rule.Freq == "" {
+		return nil, fmt.Errorf("rrule must contain a FREQ part")
+	}
+	if rule.Count > 0 && rule.UntilValue != "" {
+		return nil, fmt.Errorf("rrule must not contain both COUNT and UNTIL")
+	}
+	for _, day := range rule.ByDay {
+		if day.N != 0 && rule.Freq != "MONTHLY" && rule.Freq != "YEARLY" {
+			return nil, fmt.Errorf("BYDAY with an ordinal (e.g. +3SA) is only valid with FREQ=MONTHLY or FREQ=YEARLY")
+		}
+	}
+	if len(rule.ByMonthDay) > 0 && rule.Freq == "WEEKLY" {
+		return nil, fmt.Errorf("BYMONTHDAY must not be used with FREQ=WEEKLY")
+	}
+
+	return rule, nil
+}
+
+func (rule *recurrenceRule) parseDtStart(line string) error {
+	nameAndValue := strings.SplitN(line, ":", 2)
+	if len(nameAndValue) != 2 {
+		return fmt.Errorf("invalid DTSTART %q", line)
+	}
+	for _, param := range strings.Split(nameAndValue[0], ";")[1:] {
+		if kv := strings.SplitN(param, "=", 2); len(kv) == 2 && strings.EqualFold(kv[0], "TZID") {
+			rule.DtStartTzid = kv[1]
+		}
+	}
+	value := strings.TrimSuffix(nameAndValue[1], "Z")
+	if _, err := time.Parse(rruleDateTimeLayout, value); err != nil {
+		return fmt.Errorf("invalid DTSTART %q, expected format YYYYMMDDTHHMMSS", nameAndValue[1])
+	}
+	if strings.HasSuffix(nameAndValue[1], "Z") && rule.DtStartTzid == "" {
+		rule.DtStartTzid = "UTC"
+	}
+	rule.DtStart = value
+	return nil
+}
+
+// parseRRuleUntil parses an UNTIL value. Values in UTC denote an instant, floating date-times are
+// local to the schedule, and a date includes the whole of that day in the schedule timezone.
+func parseRRuleUntil(value string, location *time.Location) (time.Time, error) {
+	if until, err := time.Parse(rruleDateTimeLayout+"Z", value); err == nil {
+		return until, nil
+	}
+	if until, err := time.ParseInLocation(rruleDateTimeLayout, value, location); err == nil {
+		return until, nil
+	}
+	if day, err := time.ParseInLocation("20060102", value, location); err == nil {
+		return time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, location).Add(-time.Nanosecond), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid UNTIL %q, expected format YYYYMMDD or YYYYMMDDTHHMMSSZ", value)
+}
+
+func parseRRuleIntList(name, value string, min, max int, allowZero bool) ([]int, error) {
+	var result []int
+	for _, item := range strings.Split(value, ",") {
+		i, err := strconv.Atoi(strings.TrimPrefix(item, "+"))
+		if err != nil || i < min || i > max || (i == 0 && !allowZero) {
+			return nil, fmt.Errorf("invalid %s value %q, must be between %d and %d", name, item, min, max)
+		}
+		result = append(result, i)
+	}
+	return result, nil
+}
+
+// validateScheduleRecurrence checks that the rrule of a schedule is consistent with its start and timezone.
+func validateScheduleRecurrence(schedule ScheduleDefinition) (*recurrenceRule, *time.Location, time.Time, error) {
+	location, err := time.LoadLocation(schedule.TimeZone)
+	if err != nil {
+		return nil, nil, time.Time{}, fmt.Errorf("invalid schedule timezone %q: %v", schedule.TimeZone, err)
+	}
+	start, err := time.ParseInLocation("2006-01-02 15:04", schedule.StartDate+" "+schedule.StartTime, location)
+	if err != nil {
+		return nil, nil, time.Time{}, fmt.Errorf("invalid schedule start %s %s: %v", schedule.StartDate, schedule.StartTime, err)
+	}
+	if schedule.RRule == "" {
+		return nil, location, start, nil
+	}
+
+	rule, err := parseRecurrenceRule(schedule.RRule)
+	if err != nil {
+		return nil, nil, time.Time{}, fmt.Errorf("invalid schedule rrule: %v", err)
+	}
+	if rule.DtStartTzid != "" && rule.DtStartTzid != schedule.TimeZone {
+		return nil, nil, time.Time{}, fmt.Errorf("rrule DTSTART timezone %s does not match schedule timezone %s", rule.DtStartTzid, schedule.TimeZone)
+	}
+	if rule.DtStart != "" && rule.DtStart != start.Format(rruleDateTimeLayout) {
+		return nil, nil, time.Time{}, fmt.Errorf("rrule DTSTART %s does not match schedule start %s %s", rule.DtStart, schedule.StartDate, schedule.StartTime)
+	}
+	if rule.UntilValue != "" {
+		until, _ := parseRRuleUntil(rule.UntilValue, location)
+		rule.Until = &until
+	}
+	if rule.Until != nil && rule.Until.Before(start) {
+		return nil, nil, time.Time{}, fmt.Errorf("rrule UNTIL %s is before the schedule start %s %s", rule.Until.Format(time.RFC3339), schedule.StartDate, schedule.StartTime)
+	}
+	return rule, location, start, nil
+}
+
+// Upper bound on the number of periods walked when expanding a rule, so that rules which
+// never match (e.g. BYMONTHDAY=31;BYMONTH=2) terminate.
+const maxRecurrencePeriods = 100000
+
+// expandRecurrenceRule returns up to limit occurrence starts of the rule that are not before `after`.
+func expandRecurrenceRule(rule *recurrenceRule, start time.Time, after time.Time, limit int) (occurrences []time.Time) {
+	location := start.Location()
+	hours := rule.ByHour
+	if len(hours) == 0 {
+		hours = []int{start.Hour()}
+	}
+	minutes := rule.ByMinute
+	if len(minutes) == 0 {
+		minutes = []int{start.Minute()}
+	}
+
+	count := 0
+	periodStart := recurrencePeriodStart(rule, start)
+	for period := 0; period < maxRecurrencePeriods && len(occurrences) < limit; period++ {
+		var candidates []time.Time
+		if rule.Freq == "HOURLY" {
+			if rule.matchesDay(periodStart, start) && (len(rule.ByHour) == 0 || containsInt(rule.ByHour, periodStart.Hour())) {
+				for _, minute := range minutes {
+					candidates = append(candidates, time.Date(periodStart.Year(), periodStart.Month(), periodStart.Day(), periodStart.Hour(), minute, 0, 0, location))
+				}
+			}
+		} else {
+			for _, day := range recurrencePeriodDays(rule, periodStart) {
+				if !rule.matchesDay(day, start) {
+					continue
+				}
+				for _, hour := range hours {
+					for _, minute := range minutes {
+						candidates = append(candidates, time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, location))
+					}
+				}
+			}
+		}
+		sort.Slice(candidates, func(i, j int) bool { return candidates[i].Before(candidates[j]) })
+
+		for _, candidate := range candidates {
+			if candidate.Before(start) {
+				continue
+			}
+			if rule.Until != nil && candidate.After(*rule.Until) {
+				return occurrences
+			}
+			count++
+			if rule.Count > 0 && count > rule.Count {
+				return occurrences
+			}
+			if !candidate.Before(after) {
+				occurrences = append(occurrences, candidate)
+				if len(occurrences) == limit {
+					return occurrences
+				}
+			}
+		}
+		if rule.Until != nil && periodStart.After(*rule.Until) {
+			break
+		}
+		periodStart = nextRecurrencePeriod(rule, periodStart)
+	}
+	return occurrences
+}
+
+func recurrencePeriodStart(rule *recurrenceRule, start time.Time) time.Time {
+	location := start.Location()
+	switch rule.Freq {
+	case "HOURLY":
+		return time.Date(start.Year(), start.Month(), start.Day(), start.Hour(), 0, 0, 0, location)
+	case "WEEKLY":
+		offset := (int(start.Weekday()) - int(rule.Wkst) + 7) % 7
+		return time.Date(start.Year(), start.Month(), start.Day()-offset, 0, 0, 0, 0, location)
+	case "MONTHLY":
+		return time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, location)
+	case "YEARLY":
+		return time.Date(start.Year(), time.January, 1, 0, 0, 0, 0, location)
+	default:
+		return time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, location)
+	}
+}
+
+func nextRecurrencePeriod(rule *recurrenceRule, periodStart time.Time) time.Time {
+	y, m, d, h := periodStart.Year(), periodStart.Month(), periodStart.Day(), periodStart.Hour()
+	location := periodStart.Location()
+	switch rule.Freq {
+	case "HOURLY":
+		return time.Date(y, m, d, h+rule.Interval, 0, 0, 0, location)
+	case "WEEKLY":
+		return time.Date(y, m, d+7*rule.Interval, 0, 0, 0, 0, location)
+	case "MONTHLY":
+		return time.Date(y, m+time.Month(rule.Interval), 1, 0, 0, 0, 0, location)
+	case "YEARLY":
+		return time.Date(y+rule.Interval, time.January, 1, 0, 0, 0, 0, location)
+	default:
+		return time.Date(y, m, d+rule.Interval, 0, 0, 0, 0, location)
+	}
+}
+
+func recurrencePeriodDays(rule *recurrenceRule, periodStart time.Time) []time.Time {
+	var end time.Time
+	switch rule.Freq {
+	case "WEEKLY":
+		end = periodStart.AddDate(0, 0, 7)
+	case "MONTHLY":
+		end = periodStart.AddDate(0, 1, 0)
+	case "YEARLY":
+		end = periodStart.AddDate(1, 0, 0)
+	default:
+		end = periodStart.AddDate(0, 0, 1)
+	}
+	var days []time.Time
+	for day := periodStart; day.Before(end); day = time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, day.Location()) {
+		days = append(days, day)
+	}
+	return days
+}
+
+// matchesDay applies the BYMONTH, BYMONTHDAY and BYDAY filters, and the defaults derived from the
+// start of the schedule when a rule does not narrow down the days of its period.
+func (rule *recurrenceRule) matchesDay(day time.Time, start time.Time) bool {
+	if len(rule.ByMonth) > 0 && !containsInt(rule.ByMonth, int(day.Month())) {
+		return false
+	}
+	if len(rule.ByMonthDay) > 0 {
+		daysInMonth := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, day.Location()).Day()
+		matched := false
+		for _, monthDay := range rule.ByMonthDay {
+			if monthDay == day.Day() || (monthDay < 0 && daysInMonth+monthDay+1 == day.Day()) {
+				matched = true
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if len(rule.ByDay) > 0 {
+		matched := false
+		for _, weekday := range rule.ByDay {
+			if weekday.Weekday == day.Weekday() && (weekday.N == 0 || rule.weekdayOrdinalMatches(weekday.N, day)) {
+				matched = true
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	if len(rule.ByDay) == 0 && len(rule.ByMonthDay) == 0 {
+		switch rule.Freq {
+		case "WEEKLY":
+			return day.Weekday() == start.Weekday()
+		case "MONTHLY":
+			return day.Day() == start.Day()
+		case "YEARLY":
+			return day.Day() == start.Day() && (len(rule.ByMonth) > 0 || day.Month() == start.Month())
+		}
+	}
+	return true
+}
+
+// weekdayOrdinalMatches checks whether day is the n-th (or, for negative n, the n-th last) occurrence of
+// its weekday within its month, or within its year for yearly rules without BYMONTH.
+func (rule *recurrenceRule) weekdayOrdinalMatches(n int, day time.Time) bool {
+	var first, last time.Time
+	if rule.Freq == "YEARLY" && len(rule.ByMonth) == 0 {
+		first = time.Date(day.Year(), time.January, 1, 0, 0, 0, 0, day.Location())
+		last = time.Date(day.Year(), time.December, 31, 0, 0, 0, 0, day.Location())
+	} else {
+		first = time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location())
+		last = time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, day.Location())
+	}
+	if n > 0 {
+		return (day.YearDay()-first.YearDay())/7+1 == n
+	}
+	return (last.YearDay()-day.YearDay())/7+1 == -n
+}
+
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,201 @@
+package sumologic
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseRecurrenceRule_invalid(t *testing.T) {
+	testCases := map[string]string{
+		"":                                     "must contain a FREQ part",
+		"INTERVAL=1":                           "must contain a FREQ part",
+		"FREQ=FORTNIGHTLY":                     "invalid FREQ",
+		"FREQ=DAILY;INTERVAL=0":                "invalid INTERVAL",
+		"FREQ=WEEKLY;BYDAY=MON":                "invalid BYDAY value \"MON\"",
+		"FREQ=WEEKLY;BYDAY=+3SA":               "only valid with FREQ=MONTHLY or FREQ=YEARLY",
+		"FREQ=MONTHLY;BYDAY=0SA":               "ordinal must be between -53 and 53",
+		"FREQ=DAILY;BYHOUR=24":                 "invalid BYHOUR value \"24\"",
+		"FREQ=DAILY;BYMINUTE=60":               "invalid BYMINUTE value \"60\"",
+		"FREQ=MONTHLY;BYMONTHDAY=0":            "invalid BYMONTHDAY value \"0\"",
+		"FREQ=YEARLY;BYMONTH=13":               "invalid BYMONTH value \"13\"",
+		"FREQ=DAILY;COUNT=3;UNTIL=20300101":    "both COUNT and UNTIL",
+		"FREQ=DAILY;UNTIL=2030-01-01":          "invalid UNTIL",
+		"FREQ=DAILY;FREQ=WEEKLY":               "specified more than once",
+		"FREQ=DAILY;BYFOO=1":                   "unknown rrule part",
+		"FREQ=DAILY;INTERVAL":                  "expected NAME=VALUE",
+		"FREQ=WEEKLY;BYMONTHDAY=1":             "must not be used with FREQ=WEEKLY",
+		"DTSTART:2030-01-01\nRRULE:FREQ=DAILY": "invalid DTSTART",
+		"EXDATE:20300101T090000\nFREQ=DAILY":   "unexpected line",
+		"FREQ=MINUTELY;INTERVAL=30":            "FREQ=MINUTELY is not supported",
+		"FREQ=MONTHLY;BYDAY=MO,FR;BYSETPOS=-1": "rrule part BYSETPOS is not supported",
+		"FREQ=YEARLY;BYYEARDAY=100":            "rrule part BYYEARDAY is not supported",
+		"FREQ=YEARLY;BYWEEKNO=20":              "rrule part BYWEEKNO is not supported",
+		"FREQ=DAILY;BYSECOND=30":               "rrule part BYSECOND is not supported",
+	}
+
+	for rrule, expectedError := range testCases {
+		_, err := parseRecurrenceRule(rrule)
+		if err == nil {
+			t.Errorf("expected an error for %q", rrule)
+		} else if !strings.Contains(err.Error(), expectedError) {
+			t.Errorf("expected error for %q to contain %q, got %q", rrule, expectedError, err)
+		}
+	}
+}
+
+func TestParseRecurrenceRule_valid(t *testing.T) {
+	rule, err := parseRecurrenceRule("DTSTART;TZID=Europe/Berlin:20300101T090000\nRRULE:FREQ=MONTHLY;INTERVAL=2;BYDAY=+3SA,-1FR;WKST=SU")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule.Freq != "MONTHLY" || rule.Interval != 2 || rule.Wkst != time.Sunday {
+		t.Errorf("unexpected rule %+v", rule)
+	}
+	if len(rule.ByDay) != 2 || rule.ByDay[0] != (rruleWeekday{N: 3, Weekday: time.Saturday}) ||
+		rule.ByDay[1] != (rruleWeekday{N: -1, Weekday: time.Friday}) {
+		t.Errorf("unexpected BYDAY %+v", rule.ByDay)
+	}
+	if rule.DtStart != "20300101T090000" || rule.DtStartTzid != "Europe/Berlin" {
+		t.Errorf("unexpected DTSTART %s %s", rule.DtStart, rule.DtStartTzid)
+	}
+}
+
+func TestValidateScheduleRecurrence(t *testing.T) {
+	schedule := ScheduleDefinition{
+		TimeZone:  "America/Los_Angeles",
+		StartDate: "2030-01-01",
+		StartTime: "09:00",
+		Duration:  60,
+	}
+
+	testCases := map[string]string{
+		"DTSTART;TZID=Europe/Berlin:20300101T090000\nRRULE:FREQ=DAILY":       "does not match schedule timezone America/Los_Angeles",
+		"DTSTART:20300101T090000Z\nRRULE:FREQ=DAILY":                         "does not match schedule timezone America/Los_Angeles",
+		"DTSTART;TZID=America/Los_Angeles:20300101T100000\nRRULE:FREQ=DAILY": "does not match schedule start 2030-01-01 09:00",
+		"FREQ=DAILY;UNTIL=20291231T000000Z":                                  "is before the schedule start",
+	}
+	for rrule, expectedError := range testCases {
+		schedule.RRule = rrule
+		_, _, _, err := validateScheduleRecurrence(schedule)
+		if err == nil || !strings.Contains(err.Error(), expectedError) {
+			t.Errorf("expected error for %q to contain %q, got %v", rrule, expectedError, err)
+		}
+	}
+
+	schedule.RRule = "DTSTART;TZID=America/Los_Angeles:20300101T090000\nRRULE:FREQ=DAILY"
+	if _, _, _, err := validateScheduleRecurrence(schedule); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	schedule.TimeZone = "Mars/Olympus_Mons"
+	if _, _, _, err := validateScheduleRecurrence(schedule); err == nil || !strings.Contains(err.Error(), "invalid schedule timezone") {
+		t.Errorf("expected invalid timezone error, got %v", err)
+	}
+}
+
+func TestGetMutingScheduleOccurrences(t *testing.T) {
+	now := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		schedule ScheduleDefinition
+		limit    int
+		expected []string
+	}{
+		{
+			name:     "one-off",
+			schedule: ScheduleDefinition{TimeZone: "UTC", StartDate: "2030-01-02", StartTime: "10:30", Duration: 30},
+			limit:    5,
+			expected: []string{"2030-01-02T10:30:00Z/2030-01-02T11:00:00Z"},
+		},
+		{
+			name: "daily by hour",
+			schedule: ScheduleDefinition{TimeZone: "America/Los_Angeles", StartDate: "2030-01-01", StartTime: "09:00", Duration: 60,
+				RRule: "FREQ=DAILY;INTERVAL=1;BYHOUR=9,17"},
+			limit: 3,
+			expected: []string{
+				"2030-01-01T09:00:00-08:00/2030-01-01T10:00:00-08:00",
+				"2030-01-01T17:00:00-08:00/2030-01-01T18:00:00-08:00",
+				"2030-01-02T09:00:00-08:00/2030-01-02T10:00:00-08:00",
+			},
+		},
+		{
+			name: "weekly by day with count",
+			schedule: ScheduleDefinition{TimeZone: "UTC", StartDate: "2030-01-01", StartTime: "22:00", Duration: 120,
+				RRule: "FREQ=WEEKLY;BYDAY=TU,TH;COUNT=3"},
+			limit: 5,
+			expected: []string{
+				"2030-01-01T22:00:00Z/2030-01-02T00:00:00Z",
+				"2030-01-03T22:00:00Z/2030-01-04T00:00:00Z",
+				"2030-01-08T22:00:00Z/2030-01-09T00:00:00Z",
+			},
+		},
+		{
+			name: "monthly third saturday",
+			schedule: ScheduleDefinition{TimeZone: "UTC", StartDate: "2030-01-01", StartTime: "01:00", Duration: 15,
+				RRule: "FREQ=MONTHLY;INTERVAL=1;BYDAY=+3SA"},
+			limit: 2,
+			expected: []string{
+				"2030-01-19T01:00:00Z/2030-01-19T01:15:00Z",
+				"2030-02-16T01:00:00Z/2030-02-16T01:15:00Z",
+			},
+		},
+		{
+			name: "monthly last day until",
+			schedule: ScheduleDefinition{TimeZone: "UTC", StartDate: "2030-01-01", StartTime: "23:00", Duration: 60,
+				RRule: "FREQ=MONTHLY;BYMONTHDAY=-1;UNTIL=20300301T000000Z"},
+			limit: 5,
+			expected: []string{
+				"2030-01-31T23:00:00Z/2030-02-01T00:00:00Z",
+				"2030-02-28T23:00:00Z/2030-03-01T00:00:00Z",
+			},
+		},
+		{
+			name: "floating until in the schedule timezone",
+			schedule: ScheduleDefinition{TimeZone: "America/Los_Angeles", StartDate: "2030-01-01", StartTime: "09:00", Duration: 60,
+				RRule: "FREQ=DAILY;UNTIL=20300102T090000"},
+			limit: 5,
+			expected: []string{
+				"2030-01-01T09:00:00-08:00/2030-01-01T10:00:00-08:00",
+				"2030-01-02T09:00:00-08:00/2030-01-02T10:00:00-08:00",
+			},
+		},
+		{
+			name: "date until includes the whole day",
+			schedule: ScheduleDefinition{TimeZone: "America/Los_Angeles", StartDate: "2030-01-01", StartTime: "17:00", Duration: 60,
+				RRule: "FREQ=DAILY;UNTIL=20300102"},
+			limit: 5,
+			expected: []string{
+				"2030-01-01T17:00:00-08:00/2030-01-01T18:00:00-08:00",
+				"2030-01-02T17:00:00-08:00/2030-01-02T18:00:00-08:00",
+			},
+		},
+		{
+			name: "window in progress",
+			schedule: ScheduleDefinition{TimeZone: "UTC", StartDate: "2029-12-31", StartTime: "23:30", Duration: 60,
+				RRule: "FREQ=DAILY"},
+			limit: 2,
+			expected: []string{
+				"2029-12-31T23:30:00Z/2030-01-01T00:30:00Z",
+				"2030-01-01T23:30:00Z/2030-01-02T00:30:00Z",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		occurrences, err := getMutingScheduleOccurrences(tc.schedule, now, tc.limit)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+		actual := make([]string, len(occurrences))
+		for i, occurrence := range occurrences {
+			window := occurrence.(map[string]interface{})
+			actual[i] = window["start"].(string) + "/" + window["end"].(string)
+		}
+		if strings.Join(actual, ",") != strings.Join(tc.expected, ",") {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.expected, actual)
+		}
+	}
+}
@@ -50,6 +50,10 @@ func Provider() *schema.Provider {
 			"sumologic_cse_inventory_entity_group_configuration": resourceSumologicCSEInventoryEntityGroupConfiguration(),
 			"sumologic_cse_entity_entity_group_configuration":    resourceSumologicCSEEntityEntityGroupConfiguration(),
 			"sumologic_cse_match_list":                           resourceSumologicCSEMatchList(),
+			"sumologic_cse_match_list_items":                     resourceSumologicCSEMatchListItems(),
+			"sumologic_cse_threat_intel_source":                  resourceSumologicCSEThreatIntelSource(),
+			"sumologic_cse_threat_intel_indicators":              resourceSumologicCSEThreatIntelIndicators(),
+			"sumologic_cse_content_pack":                         resourceSumologicCSEContentPack(),
 			"sumologic_cse_custom_match_list_column":             resourceSumologicCSECustomMatchListColumn(),
 			"sumologic_cse_log_mapping":                          resourceSumologicCSELogMapping(),
 			"sumologic_cse_rule_tuning_expression":               resourceSumologicCSERuleTuningExpression(),
@@ -90,6 +94,8 @@ func Provider() *schema.Provider {
 			"sumologic_user":                                     resourceSumologicUser(),
 			"sumologic_folder":                                   resourceSumologicFolder(),
 			"sumologic_content":                                  resourceSumologicContent(),
+			"sumologic_content_copy":                             resourceSumologicContentCopy(),
+			"sumologic_content_move":                             resourceSumologicContentMove(),
 			"sumologic_scheduled_view":                           resourceSumologicScheduledView(),
 			"sumologic_data_forwarding_destination":              resourceSumologicDataForwardingDestination(),
 			"sumologic_data_forwarding_rule":                     resourceSumologicDataForwardingRule(),
@@ -101,6 +107,7 @@ func Provider() *schema.Provider {
 			"sumologic_muting_schedule":                          resourceSumologicMutingSchedulesLibraryMutingSchedule(),
 			"sumologic_slo":                                      resourceSumologicSLO(),
 			"sumologic_slo_folder":                               resourceSumologicSLOLibraryFolder(),
+			"sumologic_slo_burn_rate_alerts":                     resourceSumologicSLOBurnRateAlerts(),
 			"sumologic_ingest_budget_v2":                         resourceSumologicIngestBudgetV2(),
 			"sumologic_field":                                    resourceSumologicField(),
 			"sumologic_lookup_table":                             resourceSumologicLookupTable(),
@@ -115,6 +122,7 @@ func Provider() *schema.Provider {
 			"sumologic_policies":                                 resourceSumologicPolicies(),
 			"sumologic_hierarchy":                                resourceSumologicHierarchy(),
 			"sumologic_content_permission":                       resourceSumologicPermissions(),
+			"sumologic_cmf_permissions":                          resourceSumologicCmfPermissions(),
 			"sumologic_local_file_source":                        resourceSumologicLocalFileSource(),
 			"sumologic_log_search":                               resourceSumologicLogSearch(),
 			"sumologic_metrics_search":                           resourceSumologicMetricsSearch(),
@@ -133,17 +141,29 @@ func Provider() *schema.Provider {
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"sumologic_cse_log_mapping_vendor_product": dataSourceCSELogMappingVendorAndProduct(),
+			"sumologic_cse_log_mapping_preview":        dataSourceSumologicCSELogMappingPreview(),
+			"sumologic_cse_rules":                      dataSourceSumologicCSERules(),
+			"sumologic_cse_rule_test":                  dataSourceSumologicCSERuleTest(),
+			"sumologic_cse_entities":                   dataSourceSumologicCSEEntities(),
+			"sumologic_cse_inventory":                  dataSourceSumologicCSEInventory(),
+			"sumologic_cse_insights":                   dataSourceSumologicCSEInsights(),
+			"sumologic_cse_signals":                    dataSourceSumologicCSESignals(),
 			"sumologic_admin_recommended_folder":       dataSourceSumologicAdminRecommendedFolder(),
 			"sumologic_caller_identity":                dataSourceSumologicCallerIdentity(),
 			"sumologic_collector":                      dataSourceSumologicCollector(),
 			"sumologic_http_source":                    dataSourceSumologicHTTPSource(),
 			"sumologic_personal_folder":                dataSourceSumologicPersonalFolder(),
 			"sumologic_folder":                         dataSourceSumologicFolder(),
+			"sumologic_dashboard_export":               dataSourceSumologicDashboardExport(),
+			"sumologic_content":                        dataSourceSumologicContent(),
+			"sumologic_content_tree":                   dataSourceSumologicContentTree(),
 			"sumologic_monitor_folder":                 dataSourceSumologicMonitorFolder(),
 			"sumologic_my_user_id":                     dataSourceSumologicMyUserId(),
 			"sumologic_partition":                      dataSourceSumologicPartition(),
 			"sumologic_partitions":                     dataSourceSumologicPartitions(),
 			"sumologic_role":                           dataSourceSumologicRole(),
+			"sumologic_slo":                            dataSourceSumologicSLO(),
+			"sumologic_slo_report":                     dataSourceSumologicSLOReport(),
 			"sumologic_role_v2":                        dataSourceSumologicRoleV2(),
 			"sumologic_user":                           dataSourceSumologicUser(),
 			"sumologic_apps":                           dataSourceSumoLogicApps(),
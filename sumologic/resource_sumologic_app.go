@@ -1,19 +1,21 @@
 package sumologic
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceSumologicApp() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceSumologicAppCreate,
-		Read:   resourceSumologicAppRead,
-		Delete: resourceSumologicAppDelete,
-		Update: resourceSumologicAppUpdate,
+		CreateContext: resourceSumologicAppCreate,
+		ReadContext:   resourceSumologicAppRead,
+		DeleteContext: resourceSumologicAppDelete,
+		UpdateContext: resourceSumologicAppUpdate,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -34,11 +36,18 @@ func resourceSumologicApp() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			pendingJobFieldName: getPendingJobSchema(),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Read:   schema.DefaultTimeout(1 * time.Minute),
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
 		},
 	}
 }
 
-func resourceSumologicAppCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceSumologicAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*Client)
 	if d.Id() == "" {
 		uuid := d.Get("uuid").(string)
@@ -54,54 +63,75 @@ func resourceSumologicAppCreate(d *schema.ResourceData, meta interface{}) error
 		log.Printf("Installing app; uuid: %+v, version: %+v\n", uuid, version)
 		log.Println("=====================================================================")
 
-		appInstanceId, err := c.CreateAppInstance(uuid, appInstallPayload)
+		job, err := c.StartAppInstall(uuid, appInstallPayload)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
+		}
+		job.Operation = "create"
+
+		completed, diags := completeAsyncJob(ctx, d, job, d.Timeout(schema.TimeoutCreate), c.WaitForAppInstall)
+		if !completed {
+			return diags
 		}
-		d.SetId(appInstanceId)
 	}
 
-	return resourceSumologicAppRead(d, meta)
+	return resourceSumologicAppRead(ctx, d, meta)
 }
 
-func resourceSumologicAppRead(d *schema.ResourceData, meta interface{}) error {
+func resourceSumologicAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*Client)
 
+	// Finish the install or upgrade job of an earlier create or update that was interrupted
+	found, diags := resumeAsyncJob(ctx, d, d.Timeout(schema.TimeoutRead), c.WaitForAppInstall)
+	if !found {
+		return diags
+	}
+
 	id := d.Id()
 	appInstance, err := c.GetAppInstance(id)
 	log.Println("=====================================================================")
 	log.Printf("Read app instance: %+v\n", appInstance)
 	log.Println("=====================================================================")
 	if err != nil {
-		return err
+		return append(diags, diag.FromErr(err)...)
 	}
 
 	if appInstance == nil {
 		log.Printf("[WARN] AppInstance not found, removing from state: %v - %v", id, err)
 		d.SetId("")
-		return nil
+		return diags
 	}
 
 	var parameters map[string]interface{}
 	if err := json.Unmarshal([]byte(appInstance.CONFIGURATIONBLOB), &parameters); err != nil {
-		return err
+		return append(diags, diag.FromErr(err)...)
 	}
 	d.Set("uuid", appInstance.UUID)
 	d.Set("version", appInstance.VERSION)
 	d.Set("parameters", parameters)
 	d.SetId(appInstance.ID)
 
-	return nil
+	return diags
 }
 
-func resourceSumologicAppDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceSumologicAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*Client)
+
+	found, diags := resumeAsyncJob(ctx, d, d.Timeout(schema.TimeoutDelete), c.WaitForAppInstall)
+	if !found {
+		if d.Id() == "" {
+			// The app was never installed.
+			return diags
+		}
+		return append(diags, diag.Errorf("app %s can't be uninstalled while it is being installed", d.Id())...)
+	}
+
 	uuid := d.Get("uuid").(string)
 	log.Printf("Uninstalling app: %+v\n", uuid)
-	return c.DeleteAppInstance(uuid)
+	return append(diags, diag.FromErr(c.DeleteAppInstance(ctx, uuid, d.Timeout(schema.TimeoutDelete)))...)
 }
 
-func resourceSumologicAppUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceSumologicAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*Client)
 
 	uuid := d.Get("uuid").(string)
@@ -109,7 +139,7 @@ func resourceSumologicAppUpdate(d *schema.ResourceData, meta interface{}) error
 	// ensure that uuid matches with already installed instance's uuid
 	appInstance, err := c.GetAppInstance(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if uuid == appInstance.UUID {
 		version := d.Get("version").(string)
@@ -126,13 +156,18 @@ func resourceSumologicAppUpdate(d *schema.ResourceData, meta interface{}) error
 		log.Printf("Upgrading app; uuid: %+v, version: %+v\n", uuid, version)
 		log.Println("=====================================================================")
 
-		_, err := c.UpdateAppInstance(uuid, appInstallPayload)
-
+		job, err := c.StartAppUpgrade(uuid, appInstallPayload)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
+		}
+		job.Operation = "update"
+
+		completed, diags := completeAsyncJob(ctx, d, job, d.Timeout(schema.TimeoutUpdate), c.WaitForAppInstall)
+		if !completed {
+			return diags
 		}
-		return resourceSumologicAppRead(d, meta)
+		return resourceSumologicAppRead(ctx, d, meta)
 	}
 
-	return fmt.Errorf("uuid is incorrect")
+	return diag.Errorf("uuid is incorrect")
 }
@@ -0,0 +1,200 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var cmfPermissionsTargetTypes = []string{"monitors", "slos", "mutingSchedules"}
+
+func resourceSumologicCmfPermissions() *schema.Resource {
+	permStmtSchema := GetCmfFgpPermStmtSchema()
+	permStmtSchema["subject_type"].ValidateFunc = validation.StringInSlice([]string{"role", "org", "user"}, false)
+
+	return &schema.Resource{
+		Create: resourceSumologicCmfPermissionsCreate,
+		Read:   resourceSumologicCmfPermissionsRead,
+		Update: resourceSumologicCmfPermissionsUpdate,
+		Delete: resourceSumologicCmfPermissionsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceSumologicCmfPermissionsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"target_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(cmfPermissionsTargetTypes, false),
+			},
+			"target_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"permission": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: permStmtSchema,
+				},
+			},
+		},
+	}
+}
+
+func resourceSumologicCmfPermissionsCreate(d *schema.ResourceData, meta interface{}) error {
+	targetType := d.Get("target_type").(string)
+	targetId := d.Get("target_id").(string)
+
+	if err := setCmfPermissions(d, meta); err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s/%s", targetType, targetId))
+
+	return resourceSumologicCmfPermissionsRead(d, meta)
+}
+
+func resourceSumologicCmfPermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+	targetType := d.Get("target_type").(string)
+	targetId := d.Get("target_id").(string)
+
+	target, err := getCmfPermissionsTarget(c, targetType, targetId)
+	if err != nil {
+		return err
+	}
+	if target == nil {
+		log.Printf("[WARN] %s %s not found, removing permissions from state", targetType, targetId)
+		d.SetId("")
+		return nil
+	}
+
+	permStmts, err := getCmfPermissions(c, targetType, target)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("permission", CmfFgpPermStmtsToResourceList(permStmts)); err != nil {
+		return fmt.Errorf("error setting permission for resource %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceSumologicCmfPermissionsUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := setCmfPermissions(d, meta); err != nil {
+		return err
+	}
+	return resourceSumologicCmfPermissionsRead(d, meta)
+}
+
+func resourceSumologicCmfPermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+	targetType := d.Get("target_type").(string)
+	targetId := d.Get("target_id").(string)
+
+	target, err := getCmfPermissionsTarget(c, targetType, targetId)
+	if err != nil || target == nil {
+		return err
+	}
+	permStmts, err := getCmfPermissions(c, targetType, target)
+	if err != nil {
+		return err
+	}
+
+	// Revoke every statement by reconciling the current permissions with an empty set.
+	_, err = c.SetCmfFgp(targetType, CmfFgpRequest{
+		PermissionStatements: ReconcileFgpPermStmtsWithEmptyPerms(nil, permStmts),
+	})
+	return err
+}
+
+func resourceSumologicCmfPermissionsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[1] == "" || !contains(cmfPermissionsTargetTypes, parts[0]) {
+		return nil, fmt.Errorf("invalid import id %q, expected <target_type>/<target_id> where target_type is one of %s",
+			d.Id(), strings.Join(cmfPermissionsTargetTypes, ", "))
+	}
+	d.Set("target_type", parts[0])
+	d.Set("target_id", parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+// setCmfPermissions makes the permission statements of the target match the configuration, revoking
+// the permissions of subjects that are no longer configured.
+func setCmfPermissions(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+	targetType := d.Get("target_type").(string)
+	targetId := d.Get("target_id").(string)
+
+	permStmts, err := CmfFgpResourceListToPermStmts(d.Get("permission").(*schema.Set).List(), targetId)
+	if err != nil {
+		return err
+	}
+
+	target, err := getCmfPermissionsTarget(c, targetType, targetId)
+	if err != nil {
+		return err
+	}
+	if target == nil {
+		return fmt.Errorf("%s with id %s not found", targetType, targetId)
+	}
+	for _, permStmt := range permStmts {
+		if permStmt.SubjectType == "user" && permStmt.SubjectId == target.CreatedBy {
+			return fmt.Errorf("permissions of user %s cannot be managed, the user owns %s %s", target.CreatedBy, targetType, targetId)
+		}
+	}
+	currentPermStmts, err := getCmfPermissions(c, targetType, target)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.SetCmfFgp(targetType, CmfFgpRequest{
+		PermissionStatements: ReconcileFgpPermStmtsWithEmptyPerms(permStmts, currentPermStmts),
+	})
+	return err
+}
+
+type cmfPermissionsTarget struct {
+	ID        string `json:"id"`
+	CreatedBy string `json:"createdBy"`
+}
+
+// getCmfPermissionsTarget returns nil if the monitor, SLO or muting schedule does not exist.
+func getCmfPermissionsTarget(c *Client, targetType string, targetId string) (*cmfPermissionsTarget, error) {
+	data, err := c.Get(fmt.Sprintf("v1/%s/%s", targetType, targetId))
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	var target cmfPermissionsTarget
+	if err := json.Unmarshal(data, &target); err != nil {
+		return nil, err
+	}
+	target.ID = targetId
+	return &target, nil
+}
+
+// getCmfPermissions returns the permission statements of the target, except for the implicit
+// statement of the user that created it.
+func getCmfPermissions(c *Client, targetType string, target *cmfPermissionsTarget) ([]CmfFgpPermStatement, error) {
+	fgpResponse, err := c.GetCmfFgpAllSubjects(targetType, target.ID)
+	if err != nil {
+		return nil, err
+	}
+	if fgpResponse == nil {
+		return []CmfFgpPermStatement{}, nil
+	}
+	return cmfFgpFilter(fgpResponse.PermissionStatements, func(perm *CmfFgpPermStatement) bool {
+		return perm.SubjectType != "user" || perm.SubjectId != target.CreatedBy
+	}), nil
+}
@@ -0,0 +1,170 @@
+package sumologic
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestGetCmfPermissions_excludesCreator(t *testing.T) {
+	body := []byte(`{
+		"permissionStatements": [
+			{"subjectId": "0000000000000001", "subjectType": "user", "targetId": "0000000000000A01", "permissions": ["Read", "Update", "Delete", "Manage"]},
+			{"subjectId": "0000000000000002", "subjectType": "user", "targetId": "0000000000000A01", "permissions": ["Read"]},
+			{"subjectId": "0000000000000003", "subjectType": "role", "targetId": "0000000000000A01", "permissions": ["Read", "Update"]}
+		]
+	}`)
+	client := newTestClient(&http.Response{
+		Status:     http.StatusText(200),
+		StatusCode: 200,
+		Body:       io.NopCloser(bytes.NewReader(body)),
+	})
+
+	permStmts, err := getCmfPermissions(client, "monitors",
+		&cmfPermissionsTarget{ID: "0000000000000A01", CreatedBy: "0000000000000001"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(permStmts) != 2 {
+		t.Fatalf("expected 2 permission statements, got %+v", permStmts)
+	}
+	if permStmts[0].SubjectType != "user" || permStmts[0].SubjectId != "0000000000000002" {
+		t.Errorf("expected statement of user 0000000000000002, got %+v", permStmts[0])
+	}
+	if permStmts[1].SubjectType != "role" || permStmts[1].SubjectId != "0000000000000003" {
+		t.Errorf("expected statement of role 0000000000000003, got %+v", permStmts[1])
+	}
+}
+
+func TestResourceSumologicMonitorsLibraryFolder_objPermissionManagedExternally(t *testing.T) {
+	for _, managedExternally := range []bool{false, true} {
+		client, httpClient := newRoutingTestClient(map[string]string{
+			"v1/monitors/0000000000000A01": `{"id": "0000000000000A01", "name": "Team", "type": "MonitorsLibraryFolderExport"}`,
+			"v1/monitors/0000000000000A01/permissions": `{"permissionStatements": [
+				{"subjectId": "0000000000000003", "subjectType": "role", "targetId": "0000000000000A01", "permissions": ["Read"]}
+			]}`,
+			"v1/monitors/permissions/set": `{"permissionStatements": []}`,
+		})
+		d := schema.TestResourceDataRaw(t, resourceSumologicMonitorsLibraryFolder().Schema, map[string]interface{}{
+			"name":                              "Team",
+			"description":                       "Monitors of the team",
+			"obj_permission_managed_externally": managedExternally,
+		})
+		d.SetId("0000000000000A01")
+
+		if err := resourceSumologicMonitorsLibraryFolderUpdate(d, client); err != nil {
+			t.Fatal(err)
+		}
+
+		revoked := false
+		for _, method := range httpClient.methods {
+			if method == "PUT v1/monitors/permissions/set" {
+				revoked = true
+			}
+			if managedExternally && strings.HasSuffix(method, "permissions") {
+				t.Errorf("expected permissions that are managed externally to be left untouched, got request %s", method)
+			}
+		}
+		if !managedExternally && !revoked {
+			t.Errorf("expected the permissions that are not configured to be revoked, got requests %v", httpClient.methods)
+		}
+		if permissions := d.Get("obj_permission").(*schema.Set).Len(); managedExternally && permissions != 0 {
+			t.Errorf("expected no obj_permission in the state, got %d", permissions)
+		}
+	}
+}
+
+func TestAccSumologicCmfPermissions_basic(t *testing.T) {
+	testNameSuffix := acctest.RandString(16)
+	tfResourceKey := "sumologic_cmf_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMonitorsLibraryFolderDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSumologicCmfPermissions(testNameSuffix, `["Read", "Update"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfResourceKey, "target_type", "monitors"),
+					resource.TestCheckResourceAttr(tfResourceKey, "permission.#", "1"),
+					testAccCheckCmfPermissionsBackend(tfResourceKey, 1),
+				),
+			},
+			{
+				Config: testAccSumologicCmfPermissions(testNameSuffix, `["Read"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfResourceKey, "permission.#", "1"),
+					testAccCheckCmfPermissionsBackend(tfResourceKey, 1),
+				),
+			},
+			{
+				ResourceName:      tfResourceKey,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCmfPermissionsBackend(name string, expectedCount int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("cmf permissions not found: %s", name)
+		}
+		client := testAccProvider.Meta().(*Client)
+		target, err := getCmfPermissionsTarget(client, rs.Primary.Attributes["target_type"], rs.Primary.Attributes["target_id"])
+		if err != nil {
+			return err
+		}
+		if target == nil {
+			return fmt.Errorf("target of %s not found", name)
+		}
+		permStmts, err := getCmfPermissions(client, rs.Primary.Attributes["target_type"], target)
+		if err != nil {
+			return err
+		}
+		if len(permStmts) != expectedCount {
+			return fmt.Errorf("expected %d permission statements, got %+v", expectedCount, permStmts)
+		}
+		return nil
+	}
+}
+
+func testAccSumologicCmfPermissions(testNameSuffix string, permissions string) string {
+	return fmt.Sprintf(`
+resource "sumologic_role" "tf_test_role" {
+	name        = "tf_test_cmf_permissions_role_%s"
+	description = "Testing resource sumologic_cmf_permissions"
+	capabilities = [
+		"viewAlerts",
+		"viewMonitorsV2",
+		"manageMonitorsV2"
+	]
+}
+
+resource "sumologic_monitor_folder" "tf_test_folder" {
+	name        = "tf_test_cmf_permissions_folder_%s"
+	description = "Testing resource sumologic_cmf_permissions"
+}
+
+resource "sumologic_cmf_permissions" "test" {
+	target_type = "monitors"
+	target_id   = sumologic_monitor_folder.tf_test_folder.id
+	permission {
+		subject_type = "role"
+		subject_id   = sumologic_role.tf_test_role.id
+		permissions  = %s
+	}
+}
+`, testNameSuffix, testNameSuffix, permissions)
+}
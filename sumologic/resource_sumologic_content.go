@@ -1,11 +1,17 @@
 package sumologic
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"log"
+	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -13,10 +19,13 @@ import (
 
 func resourceSumologicContent() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceSumologicContentCreate,
-		Read:   resourceSumologicContentRead,
-		Update: resourceSumologicContentUpdate,
-		Delete: resourceSumologicContentDelete,
+		CreateContext: resourceSumologicContentCreate,
+		ReadContext:   resourceSumologicContentRead,
+		UpdateContext: resourceSumologicContentUpdate,
+		DeleteContext: resourceSumologicContentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceSumologicContentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"parent_id": {
@@ -28,9 +37,19 @@ func resourceSumologicContent() *schema.Resource {
 				Type:             schema.TypeString,
 				ValidateFunc:     validation.StringIsJSON,
 				Required:         true,
-				DiffSuppressFunc: structure.SuppressJsonDiff,
+				DiffSuppressFunc: suppressContentConfigDiff,
 				StateFunc:        configStateFunc,
 			},
+			"ignore_fields": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(/([^~]|~[01])*)+$`),
+						"must be a JSON pointer, for example /panels/*/visualSettings"),
+				},
+			},
+			pendingJobFieldName: getPendingJobSchema(),
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Read:   schema.DefaultTimeout(1 * time.Minute),
@@ -81,6 +100,81 @@ func normalizeConfig(originalConfig string) string {
 	return configString
 }
 
+// suppressContentConfigDiff suppresses the diff of config if the old and new config are equivalent JSON
+// once the fields selected by ignore_fields are removed from both.
+func suppressContentConfigDiff(k, old, new string, d *schema.ResourceData) bool {
+	if structure.SuppressJsonDiff(k, old, new, d) {
+		return true
+	}
+	ignoreFields := d.Get("ignore_fields").([]interface{})
+	if len(ignoreFields) == 0 {
+		return false
+	}
+
+	oldConfig, err := structure.ExpandJsonFromString(old)
+	if err != nil {
+		return false
+	}
+	newConfig, err := structure.ExpandJsonFromString(new)
+	if err != nil {
+		return false
+	}
+	for _, pointer := range ignoreFields {
+		removeJsonPointer(oldConfig, pointer.(string))
+		removeJsonPointer(newConfig, pointer.(string))
+	}
+	return reflect.DeepEqual(oldConfig, newConfig)
+}
+
+// removeJsonPointer removes the values selected by a JSON pointer (RFC 6901) from value. A "*" token
+// selects every element of an array or every member of an object.
+func removeJsonPointer(value interface{}, pointer string) {
+	tokens := strings.Split(pointer, "/")[1:]
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	removeJsonPointerTokens(value, tokens)
+}
+
+func removeJsonPointerTokens(value interface{}, tokens []string) {
+	if len(tokens) == 0 {
+		return
+	}
+	token, last := tokens[0], len(tokens) == 1
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if token == "*" {
+			for key, child := range v {
+				if last {
+					delete(v, key)
+				} else {
+					removeJsonPointerTokens(child, tokens[1:])
+				}
+			}
+		} else if child, ok := v[token]; ok {
+			if last {
+				delete(v, token)
+			} else {
+				removeJsonPointerTokens(child, tokens[1:])
+			}
+		}
+	case []interface{}:
+		// Array elements are not removed, as that would shift the indexes of the remaining elements,
+		// so the last token of a pointer into an array clears the element instead.
+		for i, child := range v {
+			if token != "*" && token != strconv.Itoa(i) {
+				continue
+			}
+			if last {
+				v[i] = nil
+			} else {
+				removeJsonPointerTokens(child, tokens[1:])
+			}
+		}
+	}
+}
+
 func fillPanelQueriesDefaultValues(config map[string]interface{}) {
 	if config["panels"] != nil {
 		panels := config["panels"].([]interface{})
@@ -108,20 +202,31 @@ func fillPanelQueriesDefaultValues(config map[string]interface{}) {
 	}
 }
 
-func resourceSumologicContentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceSumologicContentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*Client)
+
+	// Resuming an import job and exporting the content share the read timeout
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	// Finish the import job of an earlier create or update that was interrupted
+	found, diags := resumeAsyncJob(ctx, d, d.Timeout(schema.TimeoutRead), c.WaitForContentImport)
+	if !found {
+		return diags
+	}
+
 	// Retrieve the content Id from the state
 	id := d.Id()
 	log.Printf("[DEBUG] Looking for content with id: %s", id)
 
-	content, err := c.GetContent(id, d.Timeout(schema.TimeoutRead))
+	content, err := c.GetContent(ctx, id, d.Timeout(schema.TimeoutRead))
 	if err != nil {
-		return err
+		return append(diags, diag.FromErr(err)...)
 	}
 	if content == nil {
 		log.Printf("[WARN] Content not found, removing from state: %v - %v", id, err)
 		d.SetId("")
-		return nil
+		return diags
 	}
 
 	log.Printf("[DEBUG] content: %s", content.Name)
@@ -133,16 +238,42 @@ func resourceSumologicContentRead(d *schema.ResourceData, meta interface{}) erro
 
 	normalizedConfig := normalizeConfig(content.Config)
 	d.Set("config", normalizedConfig)
-	return nil
+	return diags
 }
 
-func resourceSumologicContentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceSumologicContentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	c := meta.(*Client)
+
+	// The exported content doesn't include its folder, so look it up through the path of the content.
+	content, err := c.GetContentItem(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if content == nil {
+		return nil, fmt.Errorf("content with id %s does not exist", d.Id())
+	}
+	d.Set("parent_id", content.ParentId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceSumologicContentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*Client)
+
+	found, diags := resumeAsyncJob(ctx, d, d.Timeout(schema.TimeoutDelete), c.WaitForContentImport)
+	if !found {
+		if d.Id() == "" {
+			// The content was never created.
+			return diags
+		}
+		return append(diags, diag.Errorf("content %s can't be deleted while it is being imported", d.Id())...)
+	}
+
 	log.Printf("Deleting content with id: %s", d.Id())
-	return c.DeleteContent(d.Id(), d.Timeout(schema.TimeoutDelete))
+	return append(diags, diag.FromErr(c.DeleteContent(ctx, d.Id(), d.Timeout(schema.TimeoutDelete)))...)
 }
 
-func resourceSumologicContentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceSumologicContentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*Client)
 
 	// If there is no id in the state, then we need to create the object
@@ -150,32 +281,45 @@ func resourceSumologicContentCreate(d *schema.ResourceData, meta interface{}) er
 		// Load all the data we have from the schema into a Content Struct
 		content := resourceToContent(d)
 
-		id, err := c.CreateOrUpdateContent(*content, d.Timeout(schema.TimeoutCreate), false)
+		job, err := c.StartContentImport(*content, false)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
+		job.Operation = "create"
 
-		d.SetId(id)
-		log.Printf("Created content with id=%s, type=%s", id, content.Type)
+		completed, diags := completeAsyncJob(ctx, d, job, d.Timeout(schema.TimeoutCreate), c.WaitForContentImport)
+		if !completed {
+			return diags
+		}
+		log.Printf("Created content with id=%s, type=%s", d.Id(), content.Type)
 	}
 
-	return resourceSumologicContentRead(d, meta)
+	return resourceSumologicContentRead(ctx, d, meta)
 }
 
-func resourceSumologicContentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceSumologicContentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*Client)
 
+	// ignore_fields only affects the diff, so there is nothing to import if only it changed.
+	if !d.HasChange("config") {
+		return resourceSumologicContentRead(ctx, d, meta)
+	}
+
 	content := resourceToContent(d)
 
-	id, err := c.CreateOrUpdateContent(*content, d.Timeout(schema.TimeoutUpdate), true)
+	job, err := c.StartContentImport(*content, true)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
+	job.Operation = "update"
 
-	d.SetId(id)
-	log.Printf("Updated content with id=%s, type=%s", id, content.Type)
+	completed, diags := completeAsyncJob(ctx, d, job, d.Timeout(schema.TimeoutUpdate), c.WaitForContentImport)
+	if !completed {
+		return diags
+	}
+	log.Printf("Updated content with id=%s, type=%s", d.Id(), content.Type)
 
-	return resourceSumologicContentRead(d, meta)
+	return resourceSumologicContentRead(ctx, d, meta)
 }
 
 func resourceToContent(d *schema.ResourceData) *Content {
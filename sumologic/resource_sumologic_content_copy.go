@@ -0,0 +1,109 @@
+package sumologic
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSumologicContentCopy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSumologicContentCopyCreate,
+		ReadContext:   resourceSumologicContentCopyRead,
+		DeleteContext: resourceSumologicContentCopyDelete,
+
+		Schema: map[string]*schema.Schema{
+			"source_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"destination_folder_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			pendingJobFieldName: getPendingJobSchema(),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Read:   schema.DefaultTimeout(1 * time.Minute),
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+	}
+}
+
+func resourceSumologicContentCopyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*Client)
+
+	job, err := c.StartContentCopy(d.Get("source_id").(string), d.Get("destination_folder_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	job.Operation = "create"
+
+	completed, diags := completeAsyncJob(ctx, d, job, d.Timeout(schema.TimeoutCreate), c.WaitForContentCopy)
+	if !completed {
+		return diags
+	}
+	log.Printf("Copied content %s to id=%s", d.Get("source_id").(string), d.Id())
+
+	return resourceSumologicContentCopyRead(ctx, d, meta)
+}
+
+func resourceSumologicContentCopyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*Client)
+
+	// Finish the copy job of an earlier create that was interrupted
+	found, diags := resumeAsyncJob(ctx, d, d.Timeout(schema.TimeoutRead), c.WaitForContentCopy)
+	if !found {
+		return diags
+	}
+
+	content, err := c.GetContentItem(d.Id())
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	if content == nil {
+		log.Printf("[WARN] Content copy not found, removing from state: %v", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	// A copy that was moved to another folder is replaced by a new copy in the destination folder.
+	d.Set("destination_folder_id", content.ParentId)
+	d.Set("name", content.Name)
+	d.Set("type", content.ItemType)
+
+	return diags
+}
+
+func resourceSumologicContentCopyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*Client)
+
+	found, diags := resumeAsyncJob(ctx, d, d.Timeout(schema.TimeoutDelete), c.WaitForContentCopy)
+	if !found {
+		if d.Id() == "" {
+			// The content was never copied.
+			return diags
+		}
+		return append(diags, diag.Errorf("content copy %s can't be deleted while it is being copied", d.Id())...)
+	}
+
+	log.Printf("Deleting content copy with id: %s", d.Id())
+	return append(diags, diag.FromErr(c.DeleteContent(ctx, d.Id(), d.Timeout(schema.TimeoutDelete)))...)
+}
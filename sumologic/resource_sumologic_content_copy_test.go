@@ -0,0 +1,125 @@
+package sumologic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSumologicContentCopy_basic(t *testing.T) {
+	name := "terraform_test_content_copy_" + acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContentCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSumologicContentCopyConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sumologic_content_copy.test", "id"),
+					resource.TestCheckResourceAttrPair("sumologic_content_copy.test", "destination_folder_id",
+						"sumologic_folder.destination", "id"),
+					resource.TestCheckResourceAttr("sumologic_content_copy.test", "name", "golden"),
+					resource.TestCheckResourceAttr("sumologic_content_copy.test", "type", "Folder"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSumologicContentMove_basic(t *testing.T) {
+	name := "terraform_test_content_move_" + acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSumologicContentMoveConfig(name, "destination"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("sumologic_content_move.test", "destination_folder_id",
+						"sumologic_folder.destination", "id"),
+					resource.TestCheckResourceAttrPair("sumologic_content_move.test", "original_folder_id",
+						"sumologic_folder.source", "id"),
+				),
+			},
+			{
+				Config: testAccSumologicContentMoveConfig(name, "source"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("sumologic_content_move.test", "destination_folder_id",
+						"sumologic_folder.source", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContentCopyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Client)
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "sumologic_content_copy" {
+			continue
+		}
+		content, err := client.GetContentItem(r.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Encountered an error: %s", err)
+		}
+		if content != nil {
+			return fmt.Errorf("Content copy %s still exists", r.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccSumologicContentCopyFolders(name string) string {
+	return fmt.Sprintf(`
+data "sumologic_personal_folder" "personalFolder" {}
+resource "sumologic_folder" "source" {
+  name = "%s_source"
+  parent_id = data.sumologic_personal_folder.personalFolder.id
+  description = "test"
+}
+resource "sumologic_folder" "destination" {
+  name = "%s_destination"
+  parent_id = data.sumologic_personal_folder.personalFolder.id
+  description = "test"
+}
+`, name, name)
+}
+
+func testAccSumologicContentCopyConfig(name string) string {
+	return testAccSumologicContentCopyFolders(name) + `
+resource "sumologic_folder" "golden" {
+  name = "golden"
+  parent_id = sumologic_folder.source.id
+  description = "test"
+}
+resource "sumologic_content_copy" "test" {
+  source_id = sumologic_folder.golden.id
+  destination_folder_id = sumologic_folder.destination.id
+}
+`
+}
+
+func testAccSumologicContentMoveConfig(name string, destination string) string {
+	return testAccSumologicContentCopyFolders(name) + fmt.Sprintf(`
+resource "sumologic_content" "test" {
+  parent_id = sumologic_folder.source.id
+  config = <<JSON
+%s
+JSON
+  lifecycle {
+    ignore_changes = [parent_id]
+  }
+}
+resource "sumologic_content_move" "test" {
+  content_id = sumologic_content.test.id
+  destination_folder_id = sumologic_folder.%s.id
+  restore_on_destroy = true
+}
+`, configJson, destination)
+}
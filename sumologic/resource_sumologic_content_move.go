@@ -0,0 +1,110 @@
+package sumologic
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSumologicContentMove() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSumologicContentMoveCreate,
+		ReadContext:   resourceSumologicContentMoveRead,
+		UpdateContext: resourceSumologicContentMoveUpdate,
+		DeleteContext: resourceSumologicContentMoveDelete,
+
+		Schema: map[string]*schema.Schema{
+			"content_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"destination_folder_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"restore_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"original_folder_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSumologicContentMoveCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*Client)
+
+	id := d.Get("content_id").(string)
+	content, err := c.GetContentItem(id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if content == nil {
+		return diag.Errorf("content with id %s does not exist", id)
+	}
+
+	destinationFolderId := d.Get("destination_folder_id").(string)
+	if content.ParentId != destinationFolderId {
+		if err := c.MoveContent(id, destinationFolderId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(id)
+	d.Set("original_folder_id", content.ParentId)
+
+	return resourceSumologicContentMoveRead(ctx, d, meta)
+}
+
+func resourceSumologicContentMoveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*Client)
+
+	content, err := c.GetContentItem(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if content == nil {
+		log.Printf("[WARN] Moved content not found, removing from state: %v", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("content_id", content.ID)
+	d.Set("destination_folder_id", content.ParentId)
+
+	return nil
+}
+
+func resourceSumologicContentMoveUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*Client)
+
+	if d.HasChange("destination_folder_id") {
+		if err := c.MoveContent(d.Id(), d.Get("destination_folder_id").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceSumologicContentMoveRead(ctx, d, meta)
+}
+
+func resourceSumologicContentMoveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*Client)
+
+	originalFolderId := d.Get("original_folder_id").(string)
+	if !d.Get("restore_on_destroy").(bool) || originalFolderId == d.Get("destination_folder_id").(string) {
+		return nil
+	}
+
+	log.Printf("Moving content with id: %s back to folder: %s", d.Id(), originalFolderId)
+	return diag.FromErr(c.MoveContent(d.Id(), originalFolderId))
+}
@@ -1,7 +1,11 @@
 package sumologic
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -24,6 +28,11 @@ func TestAccContent_create(t *testing.T) {
 					testAccCheckContentAttributes("sumologic_content.test"),
 				),
 			},
+			{
+				ResourceName:      "sumologic_content.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -53,6 +62,58 @@ func TestAccContent_update(t *testing.T) {
 	})
 }
 
+func TestSuppressContentConfigDiff(t *testing.T) {
+	old := `{
+		"type": "DashboardSyncDefinition",
+		"name": "Api Health",
+		"panels": [
+			{"id": "panel-id-1", "name": "Errors", "properties": "{\"color\": \"red\"}"},
+			{"id": "panel-id-2", "name": "Latency", "properties": "{}"}
+		],
+		"filters": [],
+		"~server/added": true
+	}`
+	new := `{
+		"type": "DashboardSyncDefinition",
+		"name": "Api Health",
+		"panels": [
+			{"name": "Errors"},
+			{"name": "Latency"}
+		],
+		"filters": []
+	}`
+
+	d := resourceSumologicContent().Data(nil)
+	if suppressContentConfigDiff("config", old, new, d) {
+		t.Errorf("expected different config to cause a diff without ignore_fields")
+	}
+
+	d.Set("ignore_fields", []interface{}{"/panels/*/id", "/panels/*/properties", "/~0server~1added"})
+	if !suppressContentConfigDiff("config", old, new, d) {
+		t.Errorf("expected config that only differs in ignored fields not to cause a diff")
+	}
+
+	changed := strings.Replace(new, `"Latency"`, `"Throughput"`, 1)
+	if suppressContentConfigDiff("config", old, changed, d) {
+		t.Errorf("expected config that differs in other fields to cause a diff")
+	}
+}
+
+func TestRemoveJsonPointer(t *testing.T) {
+	var value interface{}
+	_ = json.Unmarshal([]byte(`{"a": {"b": 1, "c": 2}, "d": [{"e": 1, "f": 2}, {"e": 3}], "g": [1, 2]}`), &value)
+
+	for _, pointer := range []string{"/a/b", "/d/*/e", "/g/1", "/does/not/exist"} {
+		removeJsonPointer(value, pointer)
+	}
+
+	var expected interface{}
+	_ = json.Unmarshal([]byte(`{"a": {"c": 2}, "d": [{"f": 2}, {}], "g": [1, null]}`), &expected)
+	if !reflect.DeepEqual(expected, value) {
+		t.Errorf("expected %v, got %v", expected, value)
+	}
+}
+
 func testAccCheckContentExists(name string, content *Content, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -66,7 +127,7 @@ func testAccCheckContentExists(name string, content *Content, t *testing.T) reso
 
 		id := rs.Primary.ID
 		c := testAccProvider.Meta().(*Client)
-		newContent, err := c.GetContent(id, time.Minute)
+		newContent, err := c.GetContent(context.Background(), id, time.Minute)
 		if err != nil {
 			return fmt.Errorf("Content %s not found", id)
 		}
@@ -87,7 +148,7 @@ func testAccCheckContentAttributes(name string) resource.TestCheckFunc {
 func testAccCheckContentDestroy(content Content) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := testAccProvider.Meta().(*Client)
-		_, err := client.GetContent(content.ID, time.Minute)
+		_, err := client.GetContent(context.Background(), content.ID, time.Minute)
 		if err == nil {
 			return fmt.Errorf("Content(id=%s) still exists", content.ID)
 		}
@@ -20,15 +20,8 @@ func resourceSumologicCSEAggregationRule() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
-			if d.Id() != "" {
-				return nil
-			}
-			for _, field := range []string{"aggregation_functions", "enabled", "match_expression", "trigger_expression"} {
-				if _, ok := d.GetOk(field); !ok {
-					return fmt.Errorf("%q is required when creating a new aggregation rule", field)
-				}
-			}
-			return nil
+			return validateRuleRequiredFields(d, "aggregation", []string{"enabled"},
+				[]string{"aggregation_functions", "match_expression", "trigger_expression"})
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -123,6 +116,9 @@ func resourceSumologicCSEAggregationRule() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"rule_id":           getBuiltInRuleIdSchema(),
+			"rule_source":       getRuleSourceSchema(),
+			"built_in_defaults": getBuiltInRuleDefaultsSchema(),
 			"suppression_window_size": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -150,21 +146,16 @@ func resourceSumologicCSEAggregationRuleRead(d *schema.ResourceData, meta interf
 		return nil
 	}
 
-	d.Set("aggregation_functions", aggregationFunctionsArrayToResource(CSEAggregationRuleGet.AggregationFunctions))
 	d.Set("description_expression", CSEAggregationRuleGet.DescriptionExpression)
 	d.Set("enabled", CSEAggregationRuleGet.Enabled)
 	d.Set("entity_selectors", entitySelectorArrayToResource(CSEAggregationRuleGet.EntitySelectors))
-	d.Set("match_expression", CSEAggregationRuleGet.MatchExpression)
-	d.Set("group_by_entity", CSEAggregationRuleGet.GroupByEntity)
 	d.Set("group_by_fields", CSEAggregationRuleGet.GroupByFields)
 	d.Set("is_prototype", CSEAggregationRuleGet.IsPrototype)
-	d.Set("match_expression", CSEAggregationRuleGet.MatchExpression)
 	d.Set("name", CSEAggregationRuleGet.Name)
 	d.Set("name_expression", CSEAggregationRuleGet.NameExpression)
 	d.Set("severity_mapping", severityMappingToResource(CSEAggregationRuleGet.SeverityMapping))
 	d.Set("summary_expression", CSEAggregationRuleGet.SummaryExpression)
 	d.Set("tags", CSEAggregationRuleGet.Tags)
-	d.Set("trigger_expression", CSEAggregationRuleGet.TriggerExpression)
 	d.Set("window_size", CSEAggregationRuleGet.WindowSizeName)
 	if strings.EqualFold(CSEAggregationRuleGet.WindowSizeName, "CUSTOM") {
 		d.Set("window_size_millis", CSEAggregationRuleGet.WindowSize)
@@ -172,19 +163,47 @@ func resourceSumologicCSEAggregationRuleRead(d *schema.ResourceData, meta interf
 	if CSEAggregationRuleGet.SuppressionWindowSize != nil {
 		d.Set("suppression_window_size", CSEAggregationRuleGet.SuppressionWindowSize)
 	}
-	return nil
+
+	if readAllRuleFields(d, CSEAggregationRuleGet.RuleSource) {
+		d.Set("aggregation_functions", aggregationFunctionsArrayToResource(CSEAggregationRuleGet.AggregationFunctions))
+		d.Set("match_expression", CSEAggregationRuleGet.MatchExpression)
+		d.Set("group_by_entity", CSEAggregationRuleGet.GroupByEntity)
+		d.Set("trigger_expression", CSEAggregationRuleGet.TriggerExpression)
+	}
+	d.Set("rule_source", CSEAggregationRuleGet.RuleSource)
+
+	return setBuiltInRuleDefaults(d, CSEAggregationRuleGet.RuleSource, CSEAggregationRuleGet.Enabled)
 }
 
 func resourceSumologicCSEAggregationRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	c := meta.(*Client)
 
-	return c.DeleteCSEAggregationRule(d.Id())
+	if isBuiltInRule(d.Get("rule_source").(string)) {
+		return restoreBuiltInRule(d, c, "aggregation")
+	}
 
+	return c.DeleteCSEAggregationRule(d.Id())
 }
 
 func resourceSumologicCSEAggregationRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	c := meta.(*Client)
 
+	if ruleId, ok := d.GetOk("rule_id"); ok && d.Id() == "" {
+		CSEAggregationRuleGet, err := c.GetCSEAggregationRule(ruleId.(string))
+		if err != nil {
+			return err
+		}
+		if CSEAggregationRuleGet == nil {
+			return fmt.Errorf("CSE Aggregation Rule with id %s does not exist", ruleId)
+		}
+
+		err = adoptBuiltInRule(d, ruleId.(string), CSEAggregationRuleGet.RuleSource, CSEAggregationRuleGet.Enabled)
+		if err != nil {
+			return err
+		}
+		return resourceSumologicCSEAggregationRuleUpdate(d, meta)
+	}
+
 	if d.Id() == "" {
 
 		var suppressionWindowSize *int = nil
@@ -235,6 +254,9 @@ func resourceSumologicCSEAggregationRuleUpdate(d *schema.ResourceData, meta inte
 		err = c.UpdateCSEAggregationRule(CSEAggregationRule)
 	} else {
 		err = c.OverrideCSEAggregationRule(CSEAggregationRule)
+		if err == nil {
+			err = updateBuiltInRuleEnabled(d, c)
+		}
 	}
 
 	if err != nil {
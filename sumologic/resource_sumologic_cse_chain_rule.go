@@ -1,6 +1,8 @@
 package sumologic
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"strings"
 
@@ -17,6 +19,9 @@ func resourceSumologicCSEChainRule() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return validateRuleRequiredFields(d, "chain", nil, []string{"expressions_and_limits"})
+		},
 
 		Schema: map[string]*schema.Schema{
 			"description": {
@@ -30,7 +35,7 @@ func resourceSumologicCSEChainRule() *schema.Resource {
 			"entity_selectors": getEntitySelectorsSchema(),
 			"expressions_and_limits": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"expression": {
@@ -90,6 +95,9 @@ func resourceSumologicCSEChainRule() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"rule_id":           getBuiltInRuleIdSchema(),
+			"rule_source":       getRuleSourceSchema(),
+			"built_in_defaults": getBuiltInRuleDefaultsSchema(),
 			"suppression_window_size": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -120,10 +128,8 @@ func resourceSumologicCSEChainRuleRead(d *schema.ResourceData, meta interface{})
 	d.Set("description", CSEChainRuleGet.Description)
 	d.Set("enabled", CSEChainRuleGet.Enabled)
 	d.Set("entity_selectors", entitySelectorArrayToResource(CSEChainRuleGet.EntitySelectors))
-	d.Set("expressions_and_limits", expressionsAndLimitsArrayToResource(CSEChainRuleGet.ExpressionsAndLimits))
 	d.Set("group_by_fields", CSEChainRuleGet.GroupByFields)
 	d.Set("is_prototype", CSEChainRuleGet.IsPrototype)
-	d.Set("ordered", CSEChainRuleGet.Ordered)
 	d.Set("name", CSEChainRuleGet.Name)
 	d.Set("severity", CSEChainRuleGet.Severity)
 	d.Set("summary_expression", CSEChainRuleGet.SummaryExpression)
@@ -136,19 +142,44 @@ func resourceSumologicCSEChainRuleRead(d *schema.ResourceData, meta interface{})
 		d.Set("suppression_window_size", CSEChainRuleGet.SuppressionWindowSize)
 	}
 
-	return nil
+	if readAllRuleFields(d, CSEChainRuleGet.RuleSource) {
+		d.Set("expressions_and_limits", expressionsAndLimitsArrayToResource(CSEChainRuleGet.ExpressionsAndLimits))
+		d.Set("ordered", CSEChainRuleGet.Ordered)
+	}
+	d.Set("rule_source", CSEChainRuleGet.RuleSource)
+
+	return setBuiltInRuleDefaults(d, CSEChainRuleGet.RuleSource, CSEChainRuleGet.Enabled)
 }
 
 func resourceSumologicCSEChainRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	c := meta.(*Client)
 
-	return c.DeleteCSEChainRule(d.Id())
+	if isBuiltInRule(d.Get("rule_source").(string)) {
+		return restoreBuiltInRule(d, c, "chain")
+	}
 
+	return c.DeleteCSEChainRule(d.Id())
 }
 
 func resourceSumologicCSEChainRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	c := meta.(*Client)
 
+	if ruleId, ok := d.GetOk("rule_id"); ok && d.Id() == "" {
+		CSEChainRuleGet, err := c.GetCSEChainRule(ruleId.(string))
+		if err != nil {
+			return err
+		}
+		if CSEChainRuleGet == nil {
+			return fmt.Errorf("CSE Chain Rule with id %s does not exist", ruleId)
+		}
+
+		err = adoptBuiltInRule(d, ruleId.(string), CSEChainRuleGet.RuleSource, CSEChainRuleGet.Enabled)
+		if err != nil {
+			return err
+		}
+		return resourceSumologicCSEChainRuleUpdate(d, meta)
+	}
+
 	if d.Id() == "" {
 
 		var suppressionWindowSize *int = nil
@@ -196,6 +227,9 @@ func resourceSumologicCSEChainRuleUpdate(d *schema.ResourceData, meta interface{
 		err = c.UpdateCSEChainRule(CSEChainRule)
 	} else {
 		err = c.OverrideCSEChainRule(CSEChainRule)
+		if err == nil {
+			err = updateBuiltInRuleEnabled(d, c)
+		}
 	}
 
 	if err != nil {
@@ -0,0 +1,248 @@
+package sumologic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSumologicCSEContentPack() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceSumologicCSEContentPackCreate,
+		Read:          resourceSumologicCSEContentPackRead,
+		Delete:        resourceSumologicCSEContentPackDelete,
+		Update:        resourceSumologicCSEContentPackUpdate,
+		CustomizeDiff: resourceSumologicCSEContentPackCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (warnings []string, errors []error) {
+					if _, err := ParseCSEContentPack(v.(string)); err != nil {
+						errors = append(errors, fmt.Errorf("%q: %v", k, err))
+					}
+					return
+				},
+				DiffSuppressFunc: suppressEquivalentCSEContentPackDiff,
+			},
+			"export_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "json",
+				ValidateFunc: validation.StringInSlice([]string{"json", "yaml"}, false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"object_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"exported_content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"drifted_objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func suppressEquivalentCSEContentPackDiff(_, old, new string, _ *schema.ResourceData) bool {
+	return equivalentCSEContentPacks(old, new)
+}
+
+func equivalentCSEContentPacks(old string, new string) bool {
+	oldPack, err := ParseCSEContentPack(old)
+	if err != nil {
+		return false
+	}
+	newPack, err := ParseCSEContentPack(new)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(cseContentPackDefinitions(*oldPack), cseContentPackDefinitions(*newPack)) &&
+		oldPack.Name == newPack.Name && oldPack.Version == newPack.Version
+}
+
+// cseContentPackDefinitions returns the definitions of the objects of a pack by object key.
+func cseContentPackDefinitions(pack CSEContentPack) map[string]string {
+	definitions := make(map[string]string)
+	for _, object := range cseContentPackObjects(pack) {
+		definitions[object.key] = object.definition
+	}
+	return definitions
+}
+
+func resourceSumologicCSEContentPackCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("content") {
+		d.SetNewComputed("name")
+		d.SetNewComputed("version")
+		d.SetNewComputed("object_ids")
+		return d.SetNewComputed("exported_content")
+	}
+
+	pack, err := ParseCSEContentPack(d.Get("content").(string))
+	if err != nil {
+		return err
+	}
+	d.SetNew("name", pack.Name)
+	d.SetNew("version", pack.Version)
+
+	// Apply the pack again when it changed or when some of its objects no longer exist
+	objectIds := d.Get("object_ids").(map[string]interface{})
+	definitions := cseContentPackDefinitions(*pack)
+	changed := d.Id() == "" || len(definitions) != len(objectIds)
+	for key := range definitions {
+		if _, ok := objectIds[key]; !ok {
+			changed = true
+		}
+	}
+	if old, new := d.GetChange("content"); !equivalentCSEContentPacks(old.(string), new.(string)) {
+		changed = true
+	}
+	// Apply the objects that were changed outside of the pack again
+	if drifted := d.Get("drifted_objects").([]interface{}); len(drifted) > 0 {
+		changed = true
+		d.SetNew("drifted_objects", []string{})
+	}
+	if changed {
+		d.SetNewComputed("object_ids")
+		return d.SetNewComputed("exported_content")
+	}
+	if d.HasChange("export_format") {
+		return d.SetNewComputed("exported_content")
+	}
+	return nil
+}
+
+func resourceSumologicCSEContentPackRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	objectIds := resourceToStringMap(d.Get("object_ids").(map[string]interface{}))
+	pack, existingIds, err := c.ExportCSEContentPack(d.Get("name").(string), d.Get("version").(string), objectIds)
+	if err != nil {
+		return fmt.Errorf("error reading CSE content pack %s: %v", d.Id(), err)
+	}
+	if len(existingIds) == 0 && len(objectIds) > 0 {
+		log.Printf("[WARN] CSE content pack %s has no objects left, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	for key := range objectIds {
+		if _, ok := existingIds[key]; !ok {
+			log.Printf("[WARN] %s of CSE content pack %s not found, removing from state", key, d.Id())
+		}
+	}
+
+	exportedContent, err := FormatCSEContentPack(*pack, d.Get("export_format").(string))
+	if err != nil {
+		return err
+	}
+	d.Set("object_ids", existingIds)
+	d.Set("exported_content", exportedContent)
+
+	var drifted []string
+	if configuredPack, err := ParseCSEContentPack(d.Get("content").(string)); err == nil {
+		drifted = cseContentPackDriftedKeys(*configuredPack, *pack, existingIds)
+	}
+	for _, key := range drifted {
+		log.Printf("[WARN] %s of CSE content pack %s was changed outside of the pack", key, d.Id())
+	}
+	d.Set("drifted_objects", drifted)
+
+	return nil
+}
+
+func resourceSumologicCSEContentPackCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	pack, err := ParseCSEContentPack(d.Get("content").(string))
+	if err != nil {
+		return err
+	}
+
+	objectIds, err := c.ApplyCSEContentPack(*pack, nil, map[string]string{}, nil)
+	if len(objectIds) > 0 {
+		// Track the objects that couldn't be rolled back too
+		d.SetId(pack.Name)
+		d.Set("object_ids", objectIds)
+	}
+	if err != nil {
+		return err
+	}
+	d.SetId(pack.Name)
+	d.Set("name", pack.Name)
+	d.Set("version", pack.Version)
+
+	return resourceSumologicCSEContentPackRead(d, meta)
+}
+
+func resourceSumologicCSEContentPackUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	oldContent, newContent := d.GetChange("content")
+	pack, err := ParseCSEContentPack(newContent.(string))
+	if err != nil {
+		return err
+	}
+	previous, err := ParseCSEContentPack(oldContent.(string))
+	if err != nil {
+		previous = nil
+	}
+
+	oldObjectIds, _ := d.GetChange("object_ids")
+	oldDrifted, _ := d.GetChange("drifted_objects")
+	drifted := make(map[string]bool)
+	for _, key := range oldDrifted.([]interface{}) {
+		drifted[key.(string)] = true
+	}
+	objectIds, err := c.ApplyCSEContentPack(*pack, previous,
+		resourceToStringMap(oldObjectIds.(map[string]interface{})), drifted)
+	d.Set("object_ids", objectIds)
+	if err != nil {
+		// The pack was rolled back, so it's applied again on the next apply
+		d.Set("content", oldContent)
+		return err
+	}
+	d.Set("name", pack.Name)
+	d.Set("version", pack.Version)
+
+	return resourceSumologicCSEContentPackRead(d, meta)
+}
+
+func resourceSumologicCSEContentPackDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	objectIds, err := c.DeleteCSEContentPack(resourceToStringMap(d.Get("object_ids").(map[string]interface{})))
+	if err != nil {
+		d.Set("object_ids", objectIds)
+	}
+	return err
+}
+
+func resourceToStringMap(resourceMap map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(resourceMap))
+	for key, value := range resourceMap {
+		result[key] = value.(string)
+	}
+	return result
+}
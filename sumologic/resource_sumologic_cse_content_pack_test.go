@@ -0,0 +1,324 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const exampleCSEContentPack = `
+name: Lateral movement
+version: 1.0.0
+matchLists:
+  - name: admin_hosts
+    description: Hosts of the administrators
+    targetColumn: SrcIp
+    active: true
+    items:
+      - value: 10.0.0.1
+        description: Jump host
+rules:
+  - type: match
+    name: Admin share access
+    enabled: true
+    expression: "share_name = 'ADMIN$' and not array_contains(listMatches, 'admin_hosts')"
+    descriptionExpression: Admin share accessed
+    nameExpression: Admin share accessed
+    summaryExpression: Admin share accessed
+    isPrototype: false
+    entitySelectors:
+      - entityType: _ip
+        expression: srcDevice_ip
+    scoreMapping:
+      type: constant
+      default: 5
+    stream: record
+    tags: []
+ruleTuningExpressions:
+  - name: Exclude backups
+    description: Exclude the backup server
+    expression: "srcDevice_ip != '10.0.0.2'"
+    enabled: true
+    exclude: false
+    isGlobal: false
+    ruleIds: [Admin share access]
+customInsights:
+  - name: Lateral movement
+    description: Admin share access followed by a built-in rule
+    enabled: true
+    ordered: true
+    ruleIds: [Admin share access, MATCH-S00001]
+    severity: HIGH
+    signalNames: []
+    tags: []
+`
+
+func TestParseCSEContentPack(t *testing.T) {
+	pack, err := ParseCSEContentPack(exampleCSEContentPack)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pack.Name != "Lateral movement" || len(pack.MatchLists) != 1 || len(pack.Rules) != 1 ||
+		len(pack.RuleTuningExpressions) != 1 || len(pack.CustomInsights) != 1 {
+		t.Fatalf("unexpected content pack %+v", pack)
+	}
+	if pack.Rules[0].Type != "match" || pack.Rules[0].Name != "Admin share access" {
+		t.Errorf("unexpected rule %+v", pack.Rules[0])
+	}
+
+	// The same pack as JSON
+	exported, err := FormatCSEContentPack(*pack, "json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equivalentCSEContentPacks(exampleCSEContentPack, exported) {
+		t.Errorf("expected the JSON pack to be equivalent, got %s", exported)
+	}
+
+	for name, content := range map[string]string{
+		"no name":            "rules: []",
+		"unknown field":      "name: pack\nrule: []",
+		"unknown rule type":  "name: pack\nrules:\n  - type: other\n    name: rule",
+		"unknown rule field": "name: pack\nrules:\n  - type: match\n    name: rule\n    expresion: 'true'",
+		"duplicate rule": "name: pack\nrules:\n  - type: match\n    name: rule\n" +
+			"  - type: threshold\n    name: rule",
+		"unknown rule reference": "name: pack\ncustomInsights:\n  - name: insight\n    ruleIds: [Admin share access]",
+	} {
+		if _, err := ParseCSEContentPack(content); err == nil {
+			t.Errorf("expected an error for the pack with %s", name)
+		}
+	}
+}
+
+func exampleCSEContentPackBodies() map[string]string {
+	return map[string]string{
+		"sec/v1/match-lists":                  `{"data": {"id": "ml1"}}`,
+		"sec/v1/match-lists/ml1/items":        `{}`,
+		"sec/v1/rules/templated":              `{"data": {"id": "MATCH-U00001"}}`,
+		"sec/v1/rule-tuning-expressions":      `{"data": {"id": "rte1"}}`,
+		"sec/v1/custom-insights":              `{"data": {"id": "ci1"}}`,
+		"sec/v1/match-lists/ml1":              `{}`,
+		"sec/v1/rules/MATCH-U00001":           `{}`,
+		"sec/v1/rule-tuning-expressions/rte1": `{}`,
+		"sec/v1/custom-insights/ci1":          `{}`,
+	}
+}
+
+func TestApplyCSEContentPack(t *testing.T) {
+	client, httpClient := newRoutingTestClient(exampleCSEContentPackBodies())
+	pack, _ := ParseCSEContentPack(exampleCSEContentPack)
+
+	objectIds, err := client.ApplyCSEContentPack(*pack, nil, map[string]string{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedIds := map[string]string{
+		"match_list/admin_hosts":                 "ml1",
+		"match_rule/Admin share access":          "MATCH-U00001",
+		"rule_tuning_expression/Exclude backups": "rte1",
+		"custom_insight/Lateral movement":        "ci1",
+	}
+	if !reflect.DeepEqual(objectIds, expectedIds) {
+		t.Errorf("expected object ids %v, got %v", expectedIds, objectIds)
+	}
+	expectedMethods := []string{
+		"POST sec/v1/match-lists",
+		"POST sec/v1/match-lists/ml1/items",
+		"POST sec/v1/rules/templated",
+		"POST sec/v1/rule-tuning-expressions",
+		"POST sec/v1/custom-insights",
+	}
+	if !reflect.DeepEqual(httpClient.methods, expectedMethods) {
+		t.Errorf("expected requests %v, got %v", expectedMethods, httpClient.methods)
+	}
+
+	// The rules of the pack are referenced by id, other rules are kept as they are
+	var request CSECustomInsightRequest
+	json.Unmarshal([]byte(httpClient.requestBodies["POST sec/v1/custom-insights"]), &request)
+	expectedRuleIds := []string{"MATCH-U00001", "MATCH-S00001"}
+	if !reflect.DeepEqual(request.CSECustomInsight.RuleIds, expectedRuleIds) {
+		t.Errorf("expected rule ids %v, got %v", expectedRuleIds, request.CSECustomInsight.RuleIds)
+	}
+}
+
+func TestApplyCSEContentPack_rollback(t *testing.T) {
+	bodies := exampleCSEContentPackBodies()
+	delete(bodies, "sec/v1/custom-insights")
+	client, httpClient := newRoutingTestClient(bodies)
+	pack, _ := ParseCSEContentPack(exampleCSEContentPack)
+
+	objectIds, err := client.ApplyCSEContentPack(*pack, nil, map[string]string{}, nil)
+	if err == nil || !strings.Contains(err.Error(), "custom_insight/Lateral movement") {
+		t.Fatalf("expected the custom insight to fail, got %v", err)
+	}
+	if len(objectIds) != 0 {
+		t.Errorf("expected all objects to be rolled back, got %v", objectIds)
+	}
+	expectedDeletes := []string{
+		"DELETE sec/v1/rule-tuning-expressions/rte1",
+		"DELETE sec/v1/rules/MATCH-U00001",
+		"DELETE sec/v1/match-lists/ml1",
+	}
+	if deletes := httpClient.methods[len(httpClient.methods)-3:]; !reflect.DeepEqual(deletes, expectedDeletes) {
+		t.Errorf("expected deletes %v, got %v", expectedDeletes, deletes)
+	}
+}
+
+func TestApplyCSEContentPack_update(t *testing.T) {
+	bodies := exampleCSEContentPackBodies()
+	bodies["sec/v1/rules/templated/MATCH-U00001"] = `{}`
+	client, httpClient := newRoutingTestClient(bodies)
+	previous, _ := ParseCSEContentPack(exampleCSEContentPack)
+	pack, _ := ParseCSEContentPack(strings.Replace(
+		strings.Split(exampleCSEContentPack, "ruleTuningExpressions:")[0], "enabled: true", "enabled: false", 1))
+	pack.CustomInsights = previous.CustomInsights
+
+	objectIds, err := client.ApplyCSEContentPack(*pack, previous, map[string]string{
+		"match_list/admin_hosts":                 "ml1",
+		"match_rule/Admin share access":          "MATCH-U00001",
+		"rule_tuning_expression/Exclude backups": "rte1",
+		"custom_insight/Lateral movement":        "ci1",
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the rule changed, and the tuning expression was removed from the pack
+	expectedMethods := []string{
+		"GET sec/v1/rules/templated/MATCH-U00001",
+		"PUT sec/v1/rules/templated/MATCH-U00001",
+		"DELETE sec/v1/rule-tuning-expressions/rte1",
+	}
+	if !reflect.DeepEqual(httpClient.methods, expectedMethods) {
+		t.Errorf("expected requests %v, got %v", expectedMethods, httpClient.methods)
+	}
+	if _, ok := objectIds["rule_tuning_expression/Exclude backups"]; ok || len(objectIds) != 3 {
+		t.Errorf("expected the tuning expression to be removed, got %v", objectIds)
+	}
+}
+
+func TestApplyCSEContentPack_drifted(t *testing.T) {
+	bodies := exampleCSEContentPackBodies()
+	client, httpClient := newRoutingTestClient(bodies)
+	pack, _ := ParseCSEContentPack(exampleCSEContentPack)
+
+	_, err := client.ApplyCSEContentPack(*pack, pack, map[string]string{
+		"match_list/admin_hosts":                 "ml1",
+		"match_rule/Admin share access":          "MATCH-U00001",
+		"rule_tuning_expression/Exclude backups": "rte1",
+		"custom_insight/Lateral movement":        "ci1",
+	}, map[string]bool{"custom_insight/Lateral movement": true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The pack didn't change, only the drifted custom insight is applied again
+	expectedMethods := []string{
+		"GET sec/v1/custom-insights/ci1",
+		"PUT sec/v1/custom-insights/ci1",
+	}
+	if !reflect.DeepEqual(httpClient.methods, expectedMethods) {
+		t.Errorf("expected requests %v, got %v", expectedMethods, httpClient.methods)
+	}
+}
+
+func TestCSEContentPackDriftedKeys(t *testing.T) {
+	pack, _ := ParseCSEContentPack(exampleCSEContentPack)
+	exported, _ := ParseCSEContentPack(exampleCSEContentPack)
+	existingIds := map[string]string{
+		"match_list/admin_hosts":          "ml1",
+		"match_rule/Admin share access":   "MATCH-U00001",
+		"custom_insight/Lateral movement": "ci1",
+	}
+
+	// Fields that the pack doesn't set are ignored, and so is the order of the items
+	exported.MatchLists[0].Items = append(exported.MatchLists[0].Items, CSEMatchListItemPost{Value: "10.0.0.3"})
+	exported.MatchLists[0].Items[0], exported.MatchLists[0].Items[1] =
+		exported.MatchLists[0].Items[1], exported.MatchLists[0].Items[0]
+	pack.MatchLists[0].Items = append(pack.MatchLists[0].Items, CSEMatchListItemPost{Value: "10.0.0.3"})
+	exported.Rules[0].Fields = json.RawMessage(strings.Replace(string(exported.Rules[0].Fields), "{",
+		`{"created": "2024-03-04T10:00:00",`, 1))
+	exported.RuleTuningExpressions = nil
+	if drifted := cseContentPackDriftedKeys(*pack, *exported, existingIds); len(drifted) != 0 {
+		t.Errorf("expected no drifted objects, got %v", drifted)
+	}
+
+	exported.CustomInsights[0].Severity = "LOW"
+	expectedDrifted := []string{"custom_insight/Lateral movement"}
+	if drifted := cseContentPackDriftedKeys(*pack, *exported, existingIds); !reflect.DeepEqual(drifted, expectedDrifted) {
+		t.Errorf("expected drifted objects %v, got %v", expectedDrifted, drifted)
+	}
+}
+
+func TestCSEContentPackDriftedKeys_windowedRule(t *testing.T) {
+	client, _ := newRoutingTestClient(map[string]string{
+		"sec/v1/rules/THRESHOLD-U00001": `{"data": {"id": "THRESHOLD-U00001", "name": "Share scan", "enabled": true,
+			"expression": "metadata_deviceEventId = 'Security-5140'", "limit": 25, "score": 1,
+			"windowSize": 300000, "windowSizeName": "T05M"}}`,
+	})
+	pack, err := ParseCSEContentPack(`
+name: Share scans
+version: 1.0.0
+rules:
+  - type: threshold
+    name: Share scan
+    enabled: true
+    expression: "metadata_deviceEventId = 'Security-5140'"
+    limit: 25
+    score: 1
+    windowSize: T05M
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	existingIds := map[string]string{"threshold_rule/Share scan": "THRESHOLD-U00001"}
+
+	exported, _, err := client.ExportCSEContentPack(pack.Name, pack.Version, existingIds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The window size is exported by name, as it is defined in the pack
+	if drifted := cseContentPackDriftedKeys(*pack, *exported, existingIds); len(drifted) != 0 {
+		t.Errorf("expected no drifted objects, got %v in %s", drifted, exported.Rules[0].Fields)
+	}
+}
+
+func TestExportCSEContentPack(t *testing.T) {
+	client, _ := newRoutingTestClient(map[string]string{
+		"sec/v1/rules/MATCH-U00001": `{"data": {"id": "MATCH-U00001", "name": "Admin share access", "enabled": true,
+			"expression": "share_name = 'ADMIN$'", "scoreMapping": {"type": "constant", "default": 5}}}`,
+		"sec/v1/custom-insights/ci1": `{"data": {"id": "ci1", "name": "Lateral movement", "enabled": true,
+			"ruleIds": ["MATCH-U00001", "MATCH-S00001"], "severity": "HIGH"}}`,
+	})
+
+	pack, ids, err := client.ExportCSEContentPack("Lateral movement", "1.0.0", map[string]string{
+		"match_rule/Admin share access":          "MATCH-U00001",
+		"rule_tuning_expression/Exclude backups": "rte1",
+		"custom_insight/Lateral movement":        "ci1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := ids["rule_tuning_expression/Exclude backups"]; ok || len(ids) != 2 {
+		t.Errorf("expected the missing tuning expression not to be exported, got %v", ids)
+	}
+	expectedRuleIds := []string{"Admin share access", "MATCH-S00001"}
+	if len(pack.CustomInsights) != 1 || !reflect.DeepEqual(pack.CustomInsights[0].RuleIds, expectedRuleIds) {
+		t.Errorf("expected the custom insight to reference the rule by name, got %+v", pack.CustomInsights)
+	}
+
+	exported, err := FormatCSEContentPack(*pack, "yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exportedPack, err := ParseCSEContentPack(exported)
+	if err != nil {
+		t.Fatalf("expected the exported pack to be valid, got %v:\n%s", err, exported)
+	}
+	if len(exportedPack.Rules) != 1 || exportedPack.Rules[0].Type != "match" {
+		t.Errorf("unexpected exported rules %+v", exportedPack.Rules)
+	}
+}
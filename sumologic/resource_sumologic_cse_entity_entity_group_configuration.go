@@ -1,16 +1,24 @@
 package sumologic
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"context"
+	"fmt"
 	"log"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// cseEntityEntityGroupExpression lists the arguments selecting the entities of the group.
+var cseEntityEntityGroupExpression = []string{"entity_namespace", "entity_type", "network_block", "prefix", "suffix"}
+
 func resourceSumologicCSEEntityEntityGroupConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceSumologicCSEEntityEntityGroupConfigurationCreate,
-		Read:   resourceSumologicCSEEntityEntityGroupConfigurationRead,
-		Delete: resourceSumologicCSEEntityEntityGroupConfigurationDelete,
-		Update: resourceSumologicCSEEntityEntityGroupConfigurationUpdate,
+		Create:        resourceSumologicCSEEntityEntityGroupConfigurationCreate,
+		Read:          resourceSumologicCSEEntityEntityGroupConfigurationRead,
+		Delete:        resourceSumologicCSEEntityEntityGroupConfigurationDelete,
+		Update:        resourceSumologicCSEEntityEntityGroupConfigurationUpdate,
+		CustomizeDiff: resourceSumologicCSEEntityEntityGroupConfigurationCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -67,10 +75,54 @@ func resourceSumologicCSEEntityEntityGroupConfiguration() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"matched_entity_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
 
+// resourceSumologicCSEEntityEntityGroupConfigurationCustomizeDiff counts the entities matched by the group when
+// it is created or its expression changes. Read doesn't refresh the count, which would search the entities
+// on every plan.
+func resourceSumologicCSEEntityEntityGroupConfigurationCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChanges(cseEntityEntityGroupExpression...) {
+		return nil
+	}
+	for _, key := range cseEntityEntityGroupExpression {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("matched_entity_count")
+		}
+	}
+
+	count, err := countCSEEntityEntityGroupEntities(meta.(*Client), d.Get)
+	if err != nil {
+		log.Printf("[WARN] Could not count the entities matched by CSE Entity Entity Group Configuration %s: %v", d.Get("name"), err)
+		return d.SetNewComputed("matched_entity_count")
+	}
+	return d.SetNew("matched_entity_count", count)
+}
+
+// countCSEEntityEntityGroupEntities counts the entities currently matched by the expression of the group.
+func countCSEEntityEntityGroupEntities(c *Client, get func(string) interface{}) (int, error) {
+	search := CSEEntitySearch{
+		EntityType: get("entity_type").(string),
+		Prefix:     get("prefix").(string),
+		Suffix:     get("suffix").(string),
+		Namespace:  get("entity_namespace").(string),
+	}
+	if networkBlock := get("network_block").(string); networkBlock != "" {
+		_, ipNet, err := net.ParseCIDR(networkBlock)
+		if err != nil {
+			return 0, fmt.Errorf("invalid network_block %s: %v", networkBlock, err)
+		}
+		search.NetworkBlock = ipNet
+	}
+
+	return c.CountCSEEntities(search)
+}
+
 func resourceSumologicCSEEntityEntityGroupConfigurationRead(d *schema.ResourceData, meta interface{}) error {
 	c := meta.(*Client)
 
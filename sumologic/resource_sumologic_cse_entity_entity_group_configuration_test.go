@@ -1,6 +1,7 @@
 package sumologic
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -148,3 +149,58 @@ func testCheckEntityEntityGroupConfigurationValues(EntityEntityGroupConfiguratio
 		return nil
 	}
 }
+
+func TestResourceSumologicCSEEntityEntityGroupConfiguration_matchedEntityCount(t *testing.T) {
+	client, httpClient := newRoutingTestClient(map[string]string{
+		"sec/v1/entity-group-configurations/0000000000000001": `{"data": {
+			"id": "0000000000000001", "name": "Hosts", "entityType": "_hostname", "suffix": ".example.com"}}`,
+		"sec/v1/entities": `{"data": {"total": 2, "objects": [
+			{"id": "_hostname-web-01.example.com", "name": "web-01.example.com", "entityType": "_hostname"}
+		]}}`,
+	})
+
+	r := resourceSumologicCSEEntityEntityGroupConfiguration()
+	config := map[string]interface{}{
+		"name":        "Hosts",
+		"entity_type": "_hostname",
+		"suffix":      ".example.com",
+	}
+	diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count := diff.Attributes["matched_entity_count"]; count == nil || count.New != "2" {
+		t.Errorf("expected the plan to count 2 entities, got %v", count)
+	}
+	// The expression of the group is sent in the query, so the entities are counted from the total
+	// of the search instead of being listed.
+	expectedQuery := `entityType:"_hostname" name:"*.example.com"`
+	if len(httpClient.queries) != 1 || httpClient.queries[0] != expectedQuery {
+		t.Errorf("expected the query %s, got %v", expectedQuery, httpClient.queries)
+	}
+
+	// The count isn't refreshed by Read, nor planned again while the expression doesn't change.
+	d := r.Data(nil)
+	d.SetId("0000000000000001")
+	d.Set("matched_entity_count", 2)
+	err = resourceSumologicCSEEntityEntityGroupConfigurationRead(d, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff, err = r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil && diff.Attributes["matched_entity_count"] != nil {
+		t.Errorf("expected the count not to change, got %v", diff.Attributes["matched_entity_count"])
+	}
+	searches := 0
+	for _, request := range httpClient.requests {
+		if request == "sec/v1/entities" {
+			searches++
+		}
+	}
+	if searches != 1 {
+		t.Errorf("expected the entities to be searched once, got %d searches", searches)
+	}
+}
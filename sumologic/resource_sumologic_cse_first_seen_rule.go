@@ -1,6 +1,8 @@
 package sumologic
 
 import (
+	"context"
+	"fmt"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,11 +18,15 @@ func resourceSumologicCSEFirstSeenRule() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return validateRuleRequiredFields(d, "first seen", nil,
+				[]string{"baseline_type", "entity_selectors", "filter_expression", "value_fields"})
+		},
 
 		Schema: map[string]*schema.Schema{
 			"baseline_type": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			"baseline_window_size": {
 				Type:     schema.TypeString,
@@ -34,10 +40,10 @@ func resourceSumologicCSEFirstSeenRule() *schema.Resource {
 				Type:     schema.TypeBool,
 				Required: true,
 			},
-			"entity_selectors": getEntitySelectorsSchema(),
+			"entity_selectors": getOptionalEntitySelectorsSchema(),
 			"filter_expression": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
 				DiffSuppressFunc: suppressSpaceDiff,
 			},
 			"group_by_fields": {
@@ -82,12 +88,15 @@ func resourceSumologicCSEFirstSeenRule() *schema.Resource {
 			},
 			"value_fields": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validation.StringIsNotEmpty,
 				},
 			},
+			"rule_id":           getBuiltInRuleIdSchema(),
+			"rule_source":       getRuleSourceSchema(),
+			"built_in_defaults": getBuiltInRuleDefaultsSchema(),
 			"suppression_window_size": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -115,12 +124,9 @@ func resourceSumologicCSEFirstSeenRuleRead(d *schema.ResourceData, meta interfac
 		return nil
 	}
 
-	d.Set("baseline_type", CSEFirstSeenRuleGet.BaselineType)
 	d.Set("baseline_window_size", CSEFirstSeenRuleGet.BaselineWindowSize)
 	d.Set("description_expression", CSEFirstSeenRuleGet.DescriptionExpression)
 	d.Set("enabled", CSEFirstSeenRuleGet.Enabled)
-	d.Set("entity_selectors", entitySelectorArrayToResource(CSEFirstSeenRuleGet.EntitySelectors))
-	d.Set("filter_expression", CSEFirstSeenRuleGet.FilterExpression)
 	d.Set("group_by_fields", CSEFirstSeenRuleGet.GroupByFields)
 	d.Set("is_prototype", CSEFirstSeenRuleGet.IsPrototype)
 	d.Set("name", CSEFirstSeenRuleGet.Name)
@@ -129,24 +135,50 @@ func resourceSumologicCSEFirstSeenRuleRead(d *schema.ResourceData, meta interfac
 	d.Set("severity", CSEFirstSeenRuleGet.Severity)
 	d.Set("summary_expression", CSEFirstSeenRuleGet.SummaryExpression)
 	d.Set("tags", CSEFirstSeenRuleGet.Tags)
-	d.Set("value_fields", CSEFirstSeenRuleGet.ValueFields)
 	if CSEFirstSeenRuleGet.SuppressionWindowSize != nil {
 		d.Set("suppression_window_size", CSEFirstSeenRuleGet.SuppressionWindowSize)
 	}
 
-	return nil
+	if readAllRuleFields(d, CSEFirstSeenRuleGet.RuleSource) {
+		d.Set("baseline_type", CSEFirstSeenRuleGet.BaselineType)
+		d.Set("entity_selectors", entitySelectorArrayToResource(CSEFirstSeenRuleGet.EntitySelectors))
+		d.Set("filter_expression", CSEFirstSeenRuleGet.FilterExpression)
+		d.Set("value_fields", CSEFirstSeenRuleGet.ValueFields)
+	}
+	d.Set("rule_source", CSEFirstSeenRuleGet.RuleSource)
+
+	return setBuiltInRuleDefaults(d, CSEFirstSeenRuleGet.RuleSource, CSEFirstSeenRuleGet.Enabled)
 }
 
 func resourceSumologicCSEFirstSeenRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	c := meta.(*Client)
 
-	return c.DeleteCSEFirstSeenRule(d.Id())
+	if isBuiltInRule(d.Get("rule_source").(string)) {
+		return restoreBuiltInRule(d, c, "first-seen")
+	}
 
+	return c.DeleteCSEFirstSeenRule(d.Id())
 }
 
 func resourceSumologicCSEFirstSeenRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	c := meta.(*Client)
 
+	if ruleId, ok := d.GetOk("rule_id"); ok && d.Id() == "" {
+		CSEFirstSeenRuleGet, err := c.GetCSEFirstSeenRule(ruleId.(string))
+		if err != nil {
+			return err
+		}
+		if CSEFirstSeenRuleGet == nil {
+			return fmt.Errorf("CSE FirstSeen Rule with id %s does not exist", ruleId)
+		}
+
+		err = adoptBuiltInRule(d, ruleId.(string), CSEFirstSeenRuleGet.RuleSource, CSEFirstSeenRuleGet.Enabled)
+		if err != nil {
+			return err
+		}
+		return resourceSumologicCSEFirstSeenRuleUpdate(d, meta)
+	}
+
 	if d.Id() == "" {
 		var suppressionWindowSize *int = nil
 		if suppression, ok := d.GetOk("suppression_window_size"); ok {
@@ -195,6 +227,9 @@ func resourceSumologicCSEFirstSeenRuleUpdate(d *schema.ResourceData, meta interf
 		err = c.UpdateCSEFirstSeenRule(CSEFirstSeenRule)
 	} else {
 		err = c.OverrideCSEFirstSeenRule(CSEFirstSeenRule)
+		if err == nil {
+			err = updateBuiltInRuleEnabled(d, c)
+		}
 	}
 
 	if err != nil {
@@ -1,17 +1,23 @@
 package sumologic
 
 import (
+	"context"
+	"log"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"log"
 )
 
+// cseInventoryEntityGroupExpression lists the arguments selecting the inventory of the group.
+var cseInventoryEntityGroupExpression = []string{"group", "inventory_type", "inventory_source", "inventory_key", "inventory_value"}
+
 func resourceSumologicCSEInventoryEntityGroupConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceSumologicCSEInventoryEntityGroupConfigurationCreate,
-		Read:   resourceSumologicCSEInventoryEntityGroupConfigurationRead,
-		Delete: resourceSumologicCSEInventoryEntityGroupConfigurationDelete,
-		Update: resourceSumologicCSEInventoryEntityGroupConfigurationUpdate,
+		Create:        resourceSumologicCSEInventoryEntityGroupConfigurationCreate,
+		Read:          resourceSumologicCSEInventoryEntityGroupConfigurationRead,
+		Delete:        resourceSumologicCSEInventoryEntityGroupConfigurationDelete,
+		Update:        resourceSumologicCSEInventoryEntityGroupConfigurationUpdate,
+		CustomizeDiff: resourceSumologicCSEInventoryEntityGroupConfigurationCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -79,10 +85,52 @@ func resourceSumologicCSEInventoryEntityGroupConfiguration() *schema.Resource {
 					ValidateFunc: validation.StringIsNotEmpty,
 				},
 			},
+			"matched_entity_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
 
+// resourceSumologicCSEInventoryEntityGroupConfigurationCustomizeDiff counts the inventory matched by the group when
+// it is created or its expression changes. Read doesn't refresh the count, which would search the inventory
+// on every plan.
+func resourceSumologicCSEInventoryEntityGroupConfigurationCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChanges(cseInventoryEntityGroupExpression...) {
+		return nil
+	}
+	for _, key := range cseInventoryEntityGroupExpression {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("matched_entity_count")
+		}
+	}
+
+	count, err := countCSEInventoryEntityGroupEntities(meta.(*Client), d.Get)
+	if err != nil {
+		log.Printf("[WARN] Could not count the inventory matched by CSE Inventory Entity Group Configuration %s: %v", d.Get("name"), err)
+		return d.SetNewComputed("matched_entity_count")
+	}
+	return d.SetNew("matched_entity_count", count)
+}
+
+// countCSEInventoryEntityGroupEntities counts the inventory currently matched by the expression of the group.
+// The deprecated group argument matches the inventory having it among its groups.
+func countCSEInventoryEntityGroupEntities(c *Client, get func(string) interface{}) (int, error) {
+	search := CSEInventorySearch{
+		InventoryType: get("inventory_type").(string),
+		Source:        get("inventory_source").(string),
+		Key:           get("inventory_key").(string),
+		Value:         get("inventory_value").(string),
+	}
+	if group := get("group").(string); group != "" && search.Key == "" {
+		search.Key = "groups"
+		search.Value = group
+	}
+
+	return c.CountCSEInventory(search)
+}
+
 func resourceSumologicCSEInventoryEntityGroupConfigurationRead(d *schema.ResourceData, meta interface{}) error {
 	c := meta.(*Client)
 
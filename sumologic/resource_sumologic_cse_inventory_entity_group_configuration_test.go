@@ -160,3 +160,22 @@ func testCheckInventoryEntityGroupConfigurationValues(InventoryEntityGroupConfig
 		return nil
 	}
 }
+
+func TestCountCSEInventoryEntityGroupEntities_group(t *testing.T) {
+	client, _ := newRoutingTestClient(map[string]string{
+		"sec/v1/inventory": exampleCSEInventory,
+	})
+
+	d := resourceSumologicCSEInventoryEntityGroupConfiguration().Data(nil)
+	d.Set("inventory_type", "user")
+	d.Set("inventory_source", "ActiveDirectory")
+	d.Set("group", "VPN")
+
+	count, err := countCSEInventoryEntityGroupEntities(client, d.Get)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Errorf("expected the deprecated group to match 2 users, got %d", count)
+	}
+}
@@ -2,21 +2,36 @@ package sumologic
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	cseMatchListExpirationRecreate      = "recreate"
+	cseMatchListExpirationIgnoreExpired = "ignore_expired"
+	cseMatchListExpirationDrop          = "drop"
+
+	// cseMatchListItemExpirationLayout is the format of the expiration of match list items
+	cseMatchListItemExpirationLayout = "2006-01-02T15:04:05"
 )
 
 func resourceSumologicCSEMatchList() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceSumologicCSEMatchListCreate,
-		Read:   resourceSumologicCSEMatchListRead,
-		Delete: resourceSumologicCSEMatchListDelete,
-		Update: resourceSumologicCSEMatchListUpdate,
+		CreateContext: resourceSumologicCSEMatchListCreate,
+		ReadContext:   resourceSumologicCSEMatchListRead,
+		DeleteContext: resourceSumologicCSEMatchListDelete,
+		UpdateContext: resourceSumologicCSEMatchListUpdate,
+		CustomizeDiff: resourceSumologicCSEMatchListCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -58,6 +73,16 @@ func resourceSumologicCSEMatchList() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"expiration_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  cseMatchListExpirationRecreate,
+				ValidateFunc: validation.StringInSlice([]string{
+					cseMatchListExpirationRecreate,
+					cseMatchListExpirationIgnoreExpired,
+					cseMatchListExpirationDrop,
+				}, false),
+			},
 			"items": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -76,8 +101,15 @@ func resourceSumologicCSEMatchList() *schema.Resource {
 						"expiration": {
 							Type:     schema.TypeString,
 							Optional: true,
+							Computed: true,
 							ForceNew: false,
 						},
+						"ttl": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     false,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
 						"value": {
 							Type:     schema.TypeString,
 							Required: true,
@@ -99,11 +131,66 @@ func matchListItemHash(v interface{}) int {
 	}
 	buf.WriteString(m["value"].(string))
 	buf.WriteString(m["description"].(string))
-	buf.WriteString(m["expiration"].(string))
+	// The expiration of an item with a ttl is computed when the item is created
+	if ttl, ok := m["ttl"].(int); ok && ttl > 0 {
+		buf.WriteString(fmt.Sprintf("ttl:%d", ttl))
+	} else {
+		buf.WriteString(m["expiration"].(string))
+	}
 	return schema.HashString(buf.String())
 }
 
-func resourceSumologicCSEMatchListRead(d *schema.ResourceData, meta interface{}) error {
+func resourceSumologicCSEMatchListCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	items := d.GetRawConfig().GetAttr("items")
+	if items.IsNull() || !items.IsKnown() {
+		return nil
+	}
+
+	for it := items.ElementIterator(); it.Next(); {
+		_, item := it.Element()
+		if item.IsNull() || !item.IsKnown() {
+			continue
+		}
+		if !item.GetAttr("ttl").IsNull() && !item.GetAttr("expiration").IsNull() {
+			value := item.GetAttr("value")
+			if value.IsKnown() && !value.IsNull() && value.Type() == cty.String {
+				return fmt.Errorf("match list item '%s' can't have both an expiration and a ttl", value.AsString())
+			}
+			return fmt.Errorf("match list items can't have both an expiration and a ttl")
+		}
+	}
+
+	return nil
+}
+
+func resourceSumologicCSEMatchListRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	expiredItems, err := readCSEMatchList(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return expiredCSEMatchListItemsDiagnostics(d, expiredItems)
+}
+
+// expiredCSEMatchListItemsDiagnostics returns a warning for each expired item that is kept in the state
+// when expiration_behavior is drop, so that it is removed from the configuration.
+func expiredCSEMatchListItemsDiagnostics(d *schema.ResourceData, expiredItems []map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if d.Get("expiration_behavior").(string) == cseMatchListExpirationDrop {
+		for _, item := range expiredItems {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Match list item '%s' has expired", item["value"]),
+				Detail: fmt.Sprintf("The item expired on %s and is no longer in the match list %s. It won't be "+
+					"created again, remove it from the items of the configuration.", item["expiration"], d.Id()),
+			})
+		}
+	}
+	return diags
+}
+
+// readCSEMatchList reads the match list and its items into d and returns the items that are kept in
+// the state although they have expired, depending on expiration_behavior.
+func readCSEMatchList(d *schema.ResourceData, meta interface{}) ([]map[string]interface{}, error) {
 	c := meta.(*Client)
 
 	var cseMatchList *CSEMatchListGet
@@ -122,7 +209,7 @@ func resourceSumologicCSEMatchListRead(d *schema.ResourceData, meta interface{})
 	if cseMatchList == nil {
 		log.Printf("[WARN] CSE Match List not found, removing from state: %v - %v", id, err)
 		d.SetId("")
-		return nil
+		return nil, nil
 	}
 
 	d.Set("name", cseMatchList.Name)
@@ -134,22 +221,32 @@ func resourceSumologicCSEMatchListRead(d *schema.ResourceData, meta interface{})
 	d.Set("created_by", cseMatchList.CreatedBy)
 	d.Set("last_updated", cseMatchList.LastUpdated)
 	d.Set("last_updated_by", cseMatchList.LastUpdatedBy)
+	if d.Get("expiration_behavior").(string) == "" {
+		d.Set("expiration_behavior", cseMatchListExpirationRecreate)
+	}
 
 	cseMatchListItems, err := c.GetCSEMatchListItemsAllInMatchList(id)
 	if err != nil {
 		log.Printf("[WARN] CSE Match List items not found when looking by match list id: %s, err: %v", id, err)
 	}
 
-	if cseMatchListItems == nil {
-		d.Set("items", nil)
-	} else {
-		setItems(d, cseMatchListItems.CSEMatchListItemsAllGetObjects)
+	var items []CSEMatchListItemGet
+	if cseMatchListItems != nil {
+		items = cseMatchListItems.CSEMatchListItemsAllGetObjects
 	}
 
-	return nil
+	return setItems(d, items, time.Now()), nil
 }
 
-func setItems(d *schema.ResourceData, items []CSEMatchListItemGet) {
+// setItems sets the items of the match list. The ttl of the items isn't known to the API, it's kept from the
+// state. Items that expired since the last read are kept in the state unless expiration_behavior is recreate,
+// so that they don't show up as items to create, and are returned.
+func setItems(d *schema.ResourceData, items []CSEMatchListItemGet, now time.Time) []map[string]interface{} {
+	stateItems := make(map[string]map[string]interface{})
+	for _, data := range d.Get("items").(*schema.Set).List() {
+		item := data.(map[string]interface{})
+		stateItems[item["value"].(string)] = item
+	}
 
 	var its []map[string]interface{}
 
@@ -159,21 +256,68 @@ func setItems(d *schema.ResourceData, items []CSEMatchListItemGet) {
 			"description": t.Meta.Description,
 			"expiration":  t.Expiration,
 			"value":       t.Value,
+			"ttl":         0,
+		}
+		if stateItem, ok := stateItems[t.Value]; ok {
+			item["ttl"] = stateItem["ttl"]
+			delete(stateItems, t.Value)
 		}
 		its = append(its, item)
 	}
 
+	var expiredItems []map[string]interface{}
+	if keepsExpiredCSEMatchListItems(d) {
+		for _, stateItem := range stateItems {
+			if cseMatchListItemExpired(stateItem["expiration"].(string), now) {
+				expiredItems = append(expiredItems, stateItem)
+			}
+		}
+		sort.Slice(expiredItems, func(i, j int) bool {
+			return expiredItems[i]["value"].(string) < expiredItems[j]["value"].(string)
+		})
+		its = append(its, expiredItems...)
+	}
+
 	d.Set("items", its)
 
+	return expiredItems
 }
 
-func resourceSumologicCSEMatchListDelete(d *schema.ResourceData, meta interface{}) error {
+// keepsExpiredCSEMatchListItems returns whether expired items are neither created again nor removed from the state.
+func keepsExpiredCSEMatchListItems(d *schema.ResourceData) bool {
+	behavior := d.Get("expiration_behavior").(string)
+	return behavior == cseMatchListExpirationIgnoreExpired || behavior == cseMatchListExpirationDrop
+}
+
+// cseMatchListItemExpired returns whether the expiration of a match list item is before now.
+func cseMatchListItemExpired(expiration string, now time.Time) bool {
+	if expiration == "" {
+		return false
+	}
+	t, err := parseCSEMatchListItemExpiration(expiration)
+	if err != nil {
+		log.Printf("[WARN] Unexpected expiration of match list item: %s", expiration)
+		return false
+	}
+	return !t.After(now)
+}
+
+// parseCSEMatchListItemExpiration parses the expiration of a match list item, either with a timezone or,
+// as the API returns it, in UTC without one.
+func parseCSEMatchListItemExpiration(expiration string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, expiration)
+	if err != nil {
+		return time.Parse(cseMatchListItemExpirationLayout, expiration)
+	}
+	return t, nil
+}
+
+func resourceSumologicCSEMatchListDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*Client)
-	err := c.DeleteCSEMatchList(d.Id())
-	return err
+	return diag.FromErr(c.DeleteCSEMatchList(d.Id()))
 }
 
-func resourceSumologicCSEMatchListCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceSumologicCSEMatchListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*Client)
 
 	if d.Id() == "" {
@@ -186,7 +330,7 @@ func resourceSumologicCSEMatchListCreate(d *schema.ResourceData, meta interface{
 		})
 
 		if err != nil {
-			return fmt.Errorf("[ERROR] An error occurred converting resource to match list with id %s, err: %v", d.Id(), err)
+			return diag.Errorf("[ERROR] An error occurred converting resource to match list with id %s, err: %v", d.Id(), err)
 		}
 		d.SetId(id)
 
@@ -194,13 +338,17 @@ func resourceSumologicCSEMatchListCreate(d *schema.ResourceData, meta interface{
 		var items []CSEMatchListItemPost
 		for _, data := range itemsData {
 			item := resourceToCSEMatchListItem([]interface{}{data})
+			if keepsExpiredCSEMatchListItems(d) && cseMatchListItemExpired(item.Expiration, time.Now()) {
+				log.Printf("[DEBUG] Skipping expired match list item %s", item.Value)
+				continue
+			}
 			items = append(items, item)
 		}
 
 		if len(items) > 0 {
 			err = c.CreateCSEMatchListItems(items, id)
 			if err != nil {
-				return fmt.Errorf("[ERROR] An error occurred while adding match list items to match list with id %s, err: %v", id, err)
+				return diag.Errorf("[ERROR] An error occurred while adding match list items to match list with id %s, err: %v", id, err)
 			}
 		}
 
@@ -241,13 +389,13 @@ func resourceSumologicCSEMatchListCreate(d *schema.ResourceData, meta interface{
 			}
 		}
 
-		_, err = createStateConf.WaitForState()
+		_, err = createStateConf.WaitForStateContext(ctx)
 		if err != nil {
-			return fmt.Errorf("[ERROR] error waiting for match list with id %s to be created: %s", d.Id(), err)
+			return diag.Errorf("[ERROR] error waiting for match list with id %s to be created: %s", d.Id(), err)
 		}
 	}
 
-	return resourceSumologicCSEMatchListRead(d, meta)
+	return resourceSumologicCSEMatchListRead(ctx, d, meta)
 }
 
 func resourceToCSEMatchListItem(data interface{}) CSEMatchListItemPost {
@@ -260,19 +408,22 @@ func resourceToCSEMatchListItem(data interface{}) CSEMatchListItemPost {
 		item.Active = true
 		item.Expiration = itemObj["expiration"].(string)
 		item.Value = itemObj["value"].(string)
+		if ttl, ok := itemObj["ttl"].(int); ok && ttl > 0 && item.Expiration == "" {
+			item.Expiration = time.Now().UTC().Add(time.Duration(ttl) * time.Second).Format(cseMatchListItemExpirationLayout)
+		}
 	}
 	return item
 }
 
-func resourceSumologicCSEMatchListUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceSumologicCSEMatchListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cseMatchListPost, err := resourceToCSEMatchList(d)
 	if err != nil {
-		return fmt.Errorf("[ERROR] An error occurred converting resource to match list with id %s, err: %v", d.Id(), err)
+		return diag.Errorf("[ERROR] An error occurred converting resource to match list with id %s, err: %v", d.Id(), err)
 	}
 
 	c := meta.(*Client)
 	if err = c.UpdateCSEMatchList(cseMatchListPost); err != nil {
-		return fmt.Errorf("[ERROR] An error occurred updating match list with id %s, err: %v", d.Id(), err)
+		return diag.Errorf("[ERROR] An error occurred updating match list with id %s, err: %v", d.Id(), err)
 	}
 
 	var deleteItemIds []string
@@ -286,6 +437,10 @@ func resourceSumologicCSEMatchListUpdate(d *schema.ResourceData, meta interface{
 
 		if item.ID == "" {
 			// empty ID means item is to be added
+			if keepsExpiredCSEMatchListItems(d) && cseMatchListItemExpired(item.Expiration, time.Now()) {
+				log.Printf("[DEBUG] Skipping expired match list item %s", item.Value)
+				continue
+			}
 			addItems = append(addItems, item)
 		} else {
 			// item with and ID is already existing/potentially modified
@@ -295,7 +450,7 @@ func resourceSumologicCSEMatchListUpdate(d *schema.ResourceData, meta interface{
 
 	cseMatchListItemsAll, err := c.GetCSEMatchListItemsAllInMatchList(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] CSE Match List items not found when looking by match list id %s, err: %v", d.Id(), err)
+		return diag.Errorf("[ERROR] CSE Match List items not found when looking by match list id %s, err: %v", d.Id(), err)
 	}
 
 	// Compare currently existing match list items with the new items to determine if they should be deleted or updated
@@ -326,7 +481,7 @@ func resourceSumologicCSEMatchListUpdate(d *schema.ResourceData, meta interface{
 		if contains(deleteItemIds, oldItem.ID) {
 			err = c.DeleteCSEMatchListItem(oldItem.ID)
 			if err != nil {
-				return fmt.Errorf("[ERROR] An error occurred while deleting match list item with id %s, err: %v", oldItem.ID, err)
+				return diag.Errorf("[ERROR] An error occurred while deleting match list item with id %s, err: %v", oldItem.ID, err)
 			}
 		}
 	}
@@ -335,7 +490,7 @@ func resourceSumologicCSEMatchListUpdate(d *schema.ResourceData, meta interface{
 	for _, updateItem := range updateItems {
 		err = c.UpdateCSEMatchListItem(updateItem)
 		if err != nil {
-			return fmt.Errorf("[ERROR] An error occurred while updating match list item with id %s, err: %v", updateItem.ID, err)
+			return diag.Errorf("[ERROR] An error occurred while updating match list item with id %s, err: %v", updateItem.ID, err)
 		}
 	}
 
@@ -343,7 +498,7 @@ func resourceSumologicCSEMatchListUpdate(d *schema.ResourceData, meta interface{
 	if len(addItems) > 0 {
 		err = c.CreateCSEMatchListItems(addItems, d.Id())
 		if err != nil {
-			return fmt.Errorf("[ERROR] An error occurred while adding match list items to match list with id %s, err: %v", d.Id(), err)
+			return diag.Errorf("[ERROR] An error occurred while adding match list items to match list with id %s, err: %v", d.Id(), err)
 		}
 	}
 
@@ -365,12 +520,12 @@ func resourceSumologicCSEMatchListUpdate(d *schema.ResourceData, meta interface{
 		ContinuousTargetOccurence: 1,
 	}
 
-	_, err = updateStateConf.WaitForState()
+	_, err = updateStateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for match list with id %s to be updated: %s", d.Id(), err)
+		return diag.Errorf("[ERROR] Error waiting for match list with id %s to be updated: %s", d.Id(), err)
 	}
 
-	return resourceSumologicCSEMatchListRead(d, meta)
+	return resourceSumologicCSEMatchListRead(ctx, d, meta)
 }
 
 func matches(oldItem CSEMatchListItemGet, newItem CSEMatchListItemPost) bool {
@@ -0,0 +1,209 @@
+package sumologic
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// cseMatchListItemsBulk manages the items of a match list in bulk, identified by their value.
+var cseMatchListItemsBulk = cseBulkItems[CSEMatchListItemPost, CSEMatchListItemGet]{
+	itemName:       "match list item",
+	containerName:  "match list",
+	containerField: "match_list_id",
+	itemsField:     "items",
+	sha256Field:    "items_sha256",
+	countField:     "item_count",
+	parsers: map[string]func(io.Reader) ([]CSEMatchListItemPost, error){
+		"csv":  parseCSEMatchListItemsCsv,
+		"json": parseCSEMatchListItemsJson,
+	},
+	expandItem: func(itemObj map[string]interface{}) CSEMatchListItemPost {
+		return CSEMatchListItemPost{
+			Active:      true,
+			Description: itemObj["description"].(string),
+			Expiration:  itemObj["expiration"].(string),
+			Value:       itemObj["value"].(string),
+		}
+	},
+	value: func(item CSEMatchListItemPost) string {
+		return item.Value
+	},
+	line: func(item CSEMatchListItemPost) string {
+		return strings.Join([]string{item.Value, item.Description,
+			normalizeCSEMatchListItemExpiration(item.Expiration)}, "\t")
+	},
+	expired: func(item CSEMatchListItemPost, now time.Time) bool {
+		return cseMatchListItemExpired(item.Expiration, now)
+	},
+	existingItem: func(item CSEMatchListItemGet) (string, CSEMatchListItemPost) {
+		return item.ID, CSEMatchListItemPost{
+			Active:      true,
+			Description: item.Meta.Description,
+			Expiration:  item.Expiration,
+			Value:       item.Value,
+		}
+	},
+	listItems: listCSEMatchListItems,
+	createItems: func(c *Client, items []CSEMatchListItemPost, id string) error {
+		return c.CreateCSEMatchListItems(items, id)
+	},
+	deleteItems: func(c *Client, ids []string, id string) error {
+		return c.DeleteCSEMatchListItems(ids, id)
+	},
+}
+
+// resourceSumologicCSEMatchListItems manages the items of a match list in bulk. Its state doesn't hold
+// the items themselves but a digest of them, items_sha256, which is compared with the digest of the
+// configured items to detect changes, and the values of the items it manages, managed_values.
+func resourceSumologicCSEMatchListItems() *schema.Resource {
+	return &schema.Resource{
+		Create:        cseMatchListItemsBulk.create,
+		Read:          cseMatchListItemsBulk.read,
+		Update:        cseMatchListItemsBulk.update,
+		Delete:        cseMatchListItemsBulk.delete,
+		CustomizeDiff: cseMatchListItemsBulk.customizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"match_list_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"items": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"source_file"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"expiration": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"source_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"items"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+			"source_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"csv", "json"}, false),
+			},
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"items_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"item_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"managed_values": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// listCSEMatchListItems returns all the items of a match list, or nil if the match list doesn't exist.
+func listCSEMatchListItems(c *Client, id string) ([]CSEMatchListItemGet, error) {
+	cseMatchList, err := c.GetCSEMatchList(id, false)
+	if err != nil || cseMatchList == nil {
+		return nil, err
+	}
+
+	cseMatchListItems, err := c.GetCSEMatchListItemsAllInMatchList(id)
+	if err != nil {
+		return nil, err
+	}
+	if cseMatchListItems == nil || cseMatchListItems.CSEMatchListItemsAllGetObjects == nil {
+		return []CSEMatchListItemGet{}, nil
+	}
+	return cseMatchListItems.CSEMatchListItemsAllGetObjects, nil
+}
+
+func normalizeCSEMatchListItemExpiration(expiration string) string {
+	t, err := parseCSEMatchListItemExpiration(expiration)
+	if err != nil {
+		return expiration
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// parseCSEMatchListItemsCsv reads items from CSV with a header row, with a value column and optional
+// description and expiration columns.
+func parseCSEMatchListItemsCsv(r io.Reader) ([]CSEMatchListItemPost, error) {
+	return parseCSEBulkItemsCsv(r, []string{"value"}, func(field func(string) string) (CSEMatchListItemPost, error) {
+		if field("value") == "" {
+			return CSEMatchListItemPost{}, fmt.Errorf("value is empty")
+		}
+		return CSEMatchListItemPost{
+			Active:      true,
+			Description: field("description"),
+			Expiration:  field("expiration"),
+			Value:       field("value"),
+		}, nil
+	})
+}
+
+// parseCSEMatchListItemsJson reads items from a JSON array of objects with value, description and
+// expiration fields.
+func parseCSEMatchListItemsJson(r io.Reader) ([]CSEMatchListItemPost, error) {
+	return parseCSEBulkItemsJson(r, func(item CSEMatchListItemPost) (CSEMatchListItemPost, error) {
+		if item.Value == "" {
+			return CSEMatchListItemPost{}, fmt.Errorf("value is empty")
+		}
+		return CSEMatchListItemPost{
+			Active:      true,
+			Description: item.Description,
+			Expiration:  item.Expiration,
+			Value:       item.Value,
+		}, nil
+	})
+}
+
+// getCSEMatchListItemsAll returns the items of a match list that must exist.
+func getCSEMatchListItemsAll(c *Client, id string) ([]CSEMatchListItemGet, error) {
+	return cseMatchListItemsBulk.listExisting(c, id)
+}
+
+// diffCSEMatchListItems returns the items to add to a match list and the ids of the items to delete from it.
+func diffCSEMatchListItems(existingItems []CSEMatchListItemGet, items []CSEMatchListItemPost,
+	managedValues *schema.Set, exclusive bool) ([]CSEMatchListItemPost, []string) {
+	return cseMatchListItemsBulk.diff(existingItems, items, managedValues, exclusive)
+}
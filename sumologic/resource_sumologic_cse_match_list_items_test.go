@@ -0,0 +1,209 @@
+package sumologic
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDiffCSEMatchListItems(t *testing.T) {
+	existingItems := []CSEMatchListItemGet{
+		{ID: "1", Value: "10.0.0.1", Meta: CSEMatchListItemMeta{Description: "scanner"}},
+		{ID: "2", Value: "10.0.0.2", Meta: CSEMatchListItemMeta{Description: "scanner"},
+			Expiration: "2030-01-01T00:00:00.000Z"},
+		{ID: "3", Value: "10.0.0.3", Meta: CSEMatchListItemMeta{Description: "removed"}},
+		{ID: "4", Value: "10.0.0.4", Meta: CSEMatchListItemMeta{Description: "added by hand"}},
+	}
+	items := []CSEMatchListItemPost{
+		{Value: "10.0.0.1", Description: "scanner", Active: true},
+		{Value: "10.0.0.2", Description: "scanner", Expiration: "2030-01-01T00:00:00Z", Active: true},
+		{Value: "10.0.0.5", Description: "new", Active: true},
+	}
+	managedValues := schema.NewSet(schema.HashString, []interface{}{"10.0.0.1", "10.0.0.2", "10.0.0.3"})
+
+	addItems, deleteItemIds := cseMatchListItemsBulk.diff(existingItems, items, managedValues, false)
+	if !reflect.DeepEqual(addItems, items[2:]) {
+		t.Errorf("expected only the new item to be added, got %+v", addItems)
+	}
+	if !reflect.DeepEqual(deleteItemIds, []string{"3"}) {
+		t.Errorf("expected only the managed item that was removed to be deleted, got %v", deleteItemIds)
+	}
+
+	_, deleteItemIds = cseMatchListItemsBulk.diff(existingItems, items, managedValues, true)
+	if !reflect.DeepEqual(deleteItemIds, []string{"3", "4"}) {
+		t.Errorf("expected all the items that aren't configured to be deleted, got %v", deleteItemIds)
+	}
+
+	items[0].Description = "internal scanner"
+	addItems, deleteItemIds = cseMatchListItemsBulk.diff(existingItems, items, managedValues, false)
+	if len(addItems) != 2 || addItems[0].Value != "10.0.0.1" || !reflect.DeepEqual(deleteItemIds, []string{"1", "3"}) {
+		t.Errorf("expected the changed item to be deleted and added again, got %+v, %v", addItems, deleteItemIds)
+	}
+}
+
+func TestHashCSEMatchListItems(t *testing.T) {
+	items := []CSEMatchListItemPost{
+		{Value: "10.0.0.1", Description: "scanner", Expiration: "2030-01-01T00:00:00.000Z"},
+		{Value: "10.0.0.2", Description: "scanner"},
+	}
+	reordered := []CSEMatchListItemPost{
+		{Value: "10.0.0.2", Description: "scanner"},
+		{Value: "10.0.0.1", Description: "scanner", Expiration: "2030-01-01T00:00:00"},
+	}
+	if cseMatchListItemsBulk.hash(items) != cseMatchListItemsBulk.hash(reordered) {
+		t.Errorf("expected the digest not to depend on the order of the items or the format of expirations")
+	}
+
+	reordered[0].Description = "changed"
+	if cseMatchListItemsBulk.hash(items) == cseMatchListItemsBulk.hash(reordered) {
+		t.Errorf("expected the digest to change with the description of an item")
+	}
+}
+
+func TestExpandCSEMatchListItems_sourceFile(t *testing.T) {
+	dir := t.TempDir()
+	csvFile := filepath.Join(dir, "iocs.csv")
+	err := os.WriteFile(csvFile, []byte("value,description,expiration\n"+
+		"10.0.0.1,scanner,2030-01-01T00:00:00Z\n"+
+		"10.0.0.2,\"scanner, internal\",\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jsonFile := filepath.Join(dir, "iocs.txt")
+	err = os.WriteFile(jsonFile, []byte(`[
+		{"value": "10.0.0.1", "description": "scanner", "expiration": "2030-01-01T00:00:00Z"},
+		{"value": "10.0.0.2", "description": "scanner, internal"}
+	]`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []CSEMatchListItemPost{
+		{Value: "10.0.0.1", Description: "scanner", Expiration: "2030-01-01T00:00:00Z", Active: true},
+		{Value: "10.0.0.2", Description: "scanner, internal", Active: true},
+	}
+	for _, config := range []map[string]interface{}{
+		{"source_file": csvFile, "source_format": ""},
+		{"source_file": jsonFile, "source_format": "json"},
+	} {
+		items, err := cseMatchListItemsBulk.expand(func(key string) interface{} { return config[key] })
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(items, expected) {
+			t.Errorf("expected items %+v from %s, got %+v", expected, config["source_file"], items)
+		}
+	}
+
+	duplicateFile := filepath.Join(dir, "duplicates.csv")
+	err = os.WriteFile(duplicateFile, []byte("value\n10.0.0.1\n10.0.0.1\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = cseMatchListItemsBulk.expand(func(key string) interface{} {
+		return map[string]interface{}{"source_file": duplicateFile, "source_format": ""}[key]
+	})
+	if err == nil || !strings.Contains(err.Error(), "duplicate match list item value '10.0.0.1'") {
+		t.Errorf("expected an error for duplicate values, got %v", err)
+	}
+}
+
+func TestExpandCSEMatchListItems_items(t *testing.T) {
+	resourceSchema := resourceSumologicCSEMatchListItems().Schema
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"match_list_id": "0000000000000001",
+		"items": []interface{}{
+			map[string]interface{}{"value": "10.0.0.1", "description": "scanner", "expiration": "2030-01-01T00:00:00"},
+		},
+	})
+
+	items, err := cseMatchListItemsBulk.expand(d.Get)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []CSEMatchListItemPost{
+		{Value: "10.0.0.1", Description: "scanner", Expiration: "2030-01-01T00:00:00", Active: true},
+	}
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("expected items %+v, got %+v", expected, items)
+	}
+}
+
+func TestExpandCSEMatchListItems_expired(t *testing.T) {
+	resourceSchema := resourceSumologicCSEMatchListItems().Schema
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"match_list_id": "0000000000000001",
+		"items": []interface{}{
+			map[string]interface{}{"value": "10.0.0.1", "description": "scanner", "expiration": "2020-01-01T00:00:00"},
+			map[string]interface{}{"value": "10.0.0.2", "description": "scanner", "expiration": "2030-01-01T00:00:00Z"},
+		},
+	})
+
+	// Expired items are removed from the match list, so they are neither expected nor created again.
+	items, err := cseMatchListItemsBulk.expand(d.Get)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 1 || items[0].Value != "10.0.0.2" {
+		t.Errorf("expected only the item that has not expired, got %+v", items)
+	}
+}
+
+func TestAccSumologicCSEMatchListItems_basic(t *testing.T) {
+	SkipCseTest(t)
+
+	name := fmt.Sprintf("Terraform Items Test %s", acctest.RandString(8))
+	resourceName := "sumologic_cse_match_list_items.items"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testCSEMatchListItemsConfig(name, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "item_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "managed_values.#", "3"),
+				),
+			},
+			{
+				Config: testCSEMatchListItemsConfig(name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "item_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_values.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCSEMatchListItemsConfig(name string, numItems int) string {
+	var itemsStr = ""
+	for i := 0; i < numItems; i++ {
+		itemsStr += fmt.Sprintf(`
+  items {
+    description = "item %d"
+    value       = "10.0.0.%d"
+  }`, i, i)
+	}
+
+	return fmt.Sprintf(`
+resource "sumologic_cse_match_list" "match_list" {
+  default_ttl   = 10800
+  description   = "Match list with items managed in bulk"
+  name          = "%s"
+  target_column = "SrcIp"
+}
+
+resource "sumologic_cse_match_list_items" "items" {
+  match_list_id = sumologic_cse_match_list.match_list.id
+  %s
+}
+`, name, itemsStr)
+}
@@ -1,6 +1,7 @@
 package sumologic
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -8,8 +9,11 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccSumologicSCEMatchList_createAndUpdate(t *testing.T) {
@@ -345,3 +349,82 @@ func testCheckMatchListItemsValuesAndCount(resourceName string, expectedDescript
 		return nil
 	}
 }
+
+func TestResourceSumologicCSEMatchList_expiredItems(t *testing.T) {
+	client, _ := newRoutingTestClient(map[string]string{
+		"sec/v1/match-lists/0000000000000001": `{"data": {
+			"id": "0000000000000001",
+			"name": "Threat Intel",
+			"description": "IOCs from the threat intel feed",
+			"targetColumn": "SrcIp"
+		}}`,
+		"sec/v1/match-list-items/all": `{"data": {"objects": [
+			{"id": "2", "value": "10.0.0.2", "expiration": "2122-02-27T04:00:00", "meta": {"description": "ioc"}}
+		]}}`,
+	})
+
+	for _, behavior := range []string{cseMatchListExpirationRecreate, cseMatchListExpirationIgnoreExpired, cseMatchListExpirationDrop} {
+		d := resourceSumologicCSEMatchList().Data(nil)
+		d.SetId("0000000000000001")
+		d.Set("target_column", "SrcIp")
+		d.Set("expiration_behavior", behavior)
+		d.Set("items", []interface{}{
+			map[string]interface{}{"id": "1", "value": "10.0.0.1", "description": "ioc", "expiration": "2020-02-27T04:00:00", "ttl": 0},
+			map[string]interface{}{"id": "2", "value": "10.0.0.2", "description": "ioc", "expiration": "2122-02-27T04:00:00", "ttl": 2592000},
+		})
+
+		diags := resourceSumologicCSEMatchListRead(context.Background(), d, client)
+		assert.False(t, diags.HasError(), behavior)
+
+		items := make(map[string]map[string]interface{})
+		for _, data := range d.Get("items").(*schema.Set).List() {
+			item := data.(map[string]interface{})
+			items[item["value"].(string)] = item
+		}
+		// The ttl isn't known to the API, it's kept from the state.
+		assert.Equal(t, 2592000, items["10.0.0.2"]["ttl"], behavior)
+		if behavior == cseMatchListExpirationRecreate {
+			assert.Len(t, items, 1)
+			assert.Empty(t, diags)
+		} else {
+			assert.Len(t, items, 2, behavior)
+			assert.Equal(t, "2020-02-27T04:00:00", items["10.0.0.1"]["expiration"], behavior)
+		}
+		if behavior == cseMatchListExpirationDrop {
+			assert.Len(t, diags, 1)
+			assert.Equal(t, diag.Warning, diags[0].Severity)
+			assert.Contains(t, diags[0].Summary, "10.0.0.1")
+		}
+	}
+}
+
+func TestResourceToCSEMatchListItem_ttl(t *testing.T) {
+	before := time.Now().UTC().Truncate(time.Second)
+	item := resourceToCSEMatchListItem([]interface{}{map[string]interface{}{
+		"id": "", "value": "10.0.0.1", "description": "ioc", "expiration": "", "ttl": 2592000,
+	}})
+	expiration, err := time.Parse(cseMatchListItemExpirationLayout, item.Expiration)
+	assert.NoError(t, err)
+	assert.False(t, expiration.Before(before.Add(30*24*time.Hour)), item.Expiration)
+	assert.False(t, expiration.After(time.Now().UTC().Add(30*24*time.Hour)), item.Expiration)
+
+	// The expiration of an existing item isn't changed.
+	item = resourceToCSEMatchListItem([]interface{}{map[string]interface{}{
+		"id": "1", "value": "10.0.0.1", "description": "ioc", "expiration": "2122-02-27T04:00:00", "ttl": 2592000,
+	}})
+	assert.Equal(t, "2122-02-27T04:00:00", item.Expiration)
+
+	// The hash of an item with a ttl doesn't depend on its computed expiration.
+	assert.Equal(t,
+		matchListItemHash(map[string]interface{}{"value": "10.0.0.1", "description": "ioc", "expiration": "", "ttl": 2592000}),
+		matchListItemHash(map[string]interface{}{"value": "10.0.0.1", "description": "ioc", "expiration": "2122-02-27T04:00:00", "ttl": 2592000}))
+}
+
+func TestCSEMatchListItemExpired(t *testing.T) {
+	now := time.Date(2024, 2, 27, 4, 0, 0, 0, time.UTC)
+	assert.True(t, cseMatchListItemExpired("2024-02-27T03:59:59", now))
+	assert.True(t, cseMatchListItemExpired("2024-02-27T03:59:59.000Z", now))
+	assert.False(t, cseMatchListItemExpired("2024-02-27T04:00:01", now))
+	assert.True(t, cseMatchListItemExpired("2024-02-27T05:00:00+02:00", now))
+	assert.False(t, cseMatchListItemExpired("", now))
+}
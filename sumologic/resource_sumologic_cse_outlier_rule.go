@@ -19,15 +19,8 @@ func resourceSumologicCSEOutlierRule() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
-			if d.Id() != "" {
-				return nil
-			}
-			for _, field := range []string{"aggregation_functions", "enabled", "match_expression"} {
-				if _, ok := d.GetOk(field); !ok {
-					return fmt.Errorf("%q is required when creating a new outlier rule", field)
-				}
-			}
-			return nil
+			return validateRuleRequiredFields(d, "outlier", []string{"enabled"},
+				[]string{"aggregation_functions", "match_expression"})
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -129,6 +122,9 @@ func resourceSumologicCSEOutlierRule() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"rule_id":           getBuiltInRuleIdSchema(),
+			"rule_source":       getRuleSourceSchema(),
+			"built_in_defaults": getBuiltInRuleDefaultsSchema(),
 			"suppression_window_size": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -156,7 +152,6 @@ func resourceSumologicCSEOutlierRuleRead(d *schema.ResourceData, meta interface{
 		return nil
 	}
 
-	d.Set("aggregation_functions", aggregationFunctionsArrayToResource(CSEOutlierRuleGet.AggregationFunctions))
 	d.Set("baseline_window_size", CSEOutlierRuleGet.BaselineWindowSize)
 	d.Set("description_expression", CSEOutlierRuleGet.DescriptionExpression)
 	d.Set("deviation_threshold", CSEOutlierRuleGet.DeviationThreshold)
@@ -165,7 +160,6 @@ func resourceSumologicCSEOutlierRuleRead(d *schema.ResourceData, meta interface{
 	d.Set("floor_value", CSEOutlierRuleGet.FloorValue)
 	d.Set("group_by_fields", CSEOutlierRuleGet.GroupByFields)
 	d.Set("is_prototype", CSEOutlierRuleGet.IsPrototype)
-	d.Set("match_expression", CSEOutlierRuleGet.MatchExpression)
 	d.Set("name", CSEOutlierRuleGet.Name)
 	d.Set("name_expression", CSEOutlierRuleGet.NameExpression)
 	d.Set("retention_window_size", CSEOutlierRuleGet.RetentionWindowSize)
@@ -177,19 +171,44 @@ func resourceSumologicCSEOutlierRuleRead(d *schema.ResourceData, meta interface{
 		d.Set("suppression_window_size", CSEOutlierRuleGet.SuppressionWindowSize)
 	}
 
-	return nil
+	if readAllRuleFields(d, CSEOutlierRuleGet.RuleSource) {
+		d.Set("aggregation_functions", aggregationFunctionsArrayToResource(CSEOutlierRuleGet.AggregationFunctions))
+		d.Set("match_expression", CSEOutlierRuleGet.MatchExpression)
+	}
+	d.Set("rule_source", CSEOutlierRuleGet.RuleSource)
+
+	return setBuiltInRuleDefaults(d, CSEOutlierRuleGet.RuleSource, CSEOutlierRuleGet.Enabled)
 }
 
 func resourceSumologicCSEOutlierRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	c := meta.(*Client)
 
-	return c.DeleteCSEOutlierRule(d.Id())
+	if isBuiltInRule(d.Get("rule_source").(string)) {
+		return restoreBuiltInRule(d, c, "outlier")
+	}
 
+	return c.DeleteCSEOutlierRule(d.Id())
 }
 
 func resourceSumologicCSEOutlierRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	c := meta.(*Client)
 
+	if ruleId, ok := d.GetOk("rule_id"); ok && d.Id() == "" {
+		CSEOutlierRuleGet, err := c.GetCSEOutlierRule(ruleId.(string))
+		if err != nil {
+			return err
+		}
+		if CSEOutlierRuleGet == nil {
+			return fmt.Errorf("CSE Outlier Rule with id %s does not exist", ruleId)
+		}
+
+		err = adoptBuiltInRule(d, ruleId.(string), CSEOutlierRuleGet.RuleSource, CSEOutlierRuleGet.Enabled)
+		if err != nil {
+			return err
+		}
+		return resourceSumologicCSEOutlierRuleUpdate(d, meta)
+	}
+
 	if d.Id() == "" {
 		var suppressionWindowSize *int = nil
 		if suppression, ok := d.GetOk("suppression_window_size"); ok {
@@ -239,6 +258,9 @@ func resourceSumologicCSEOutlierRuleUpdate(d *schema.ResourceData, meta interfac
 		err = c.UpdateCSEOutlierRule(CSEOutlierRule)
 	} else {
 		err = c.OverrideCSEOutlierRule(CSEOutlierRule)
+		if err == nil {
+			err = updateBuiltInRuleEnabled(d, c)
+		}
 	}
 
 	if err != nil {
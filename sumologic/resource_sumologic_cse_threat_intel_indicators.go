@@ -0,0 +1,271 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// cseThreatIntelIndicatorsBulk manages the indicators of a threat intel source in bulk, identified by
+// their value.
+var cseThreatIntelIndicatorsBulk = cseBulkItems[CSEThreatIntelIndicator, CSEThreatIntelIndicator]{
+	itemName:       "threat intel indicator",
+	containerName:  "threat intel source",
+	containerField: "source_id",
+	itemsField:     "indicators",
+	sha256Field:    "indicators_sha256",
+	countField:     "indicator_count",
+	parsers: map[string]func(io.Reader) ([]CSEThreatIntelIndicator, error){
+		"csv":  parseCSEThreatIntelIndicatorsCsv,
+		"json": parseCSEThreatIntelIndicatorsJson,
+		"stix": parseCSEThreatIntelIndicatorsStix,
+	},
+	expandItem: func(indicatorObj map[string]interface{}) CSEThreatIntelIndicator {
+		return CSEThreatIntelIndicator{
+			Active:      true,
+			Value:       indicatorObj["value"].(string),
+			Type:        indicatorObj["type"].(string),
+			Confidence:  indicatorObj["confidence"].(int),
+			Expiration:  indicatorObj["expiration"].(string),
+			Description: indicatorObj["description"].(string),
+		}
+	},
+	value: func(indicator CSEThreatIntelIndicator) string {
+		return indicator.Value
+	},
+	line: cseThreatIntelIndicatorLine,
+	existingItem: func(indicator CSEThreatIntelIndicator) (string, CSEThreatIntelIndicator) {
+		return indicator.ID, indicator
+	},
+	listItems: listCSEThreatIntelIndicators,
+	createItems: func(c *Client, indicators []CSEThreatIntelIndicator, id string) error {
+		return c.CreateCSEThreatIntelIndicators(indicators, id)
+	},
+	deleteItems: func(c *Client, ids []string, id string) error {
+		return c.DeleteCSEThreatIntelIndicators(ids, id)
+	},
+}
+
+// resourceSumologicCSEThreatIntelIndicators manages the indicators of a threat intel source in bulk, like
+// sumologic_cse_match_list_items does for the items of a match list. Its state holds a digest of the
+// indicators, indicators_sha256, and the values of the indicators it manages, managed_values.
+func resourceSumologicCSEThreatIntelIndicators() *schema.Resource {
+	return &schema.Resource{
+		Create:        cseThreatIntelIndicatorsBulk.create,
+		Read:          cseThreatIntelIndicatorsBulk.read,
+		Update:        cseThreatIntelIndicatorsBulk.update,
+		Delete:        cseThreatIntelIndicatorsBulk.delete,
+		CustomizeDiff: cseThreatIntelIndicatorsBulk.customizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"source_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"indicators": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"source_file"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(cseThreatIntelIndicatorTypes, false),
+						},
+						"confidence": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"expiration": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"source_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"indicators"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+			"source_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"csv", "json", "stix"}, false),
+			},
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"indicators_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"indicator_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"managed_values": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// listCSEThreatIntelIndicators returns all the indicators of a threat intel source, or nil if the source
+// doesn't exist.
+func listCSEThreatIntelIndicators(c *Client, id string) ([]CSEThreatIntelIndicator, error) {
+	source, err := c.GetCSEThreatIntelSource(id)
+	if err != nil || source == nil {
+		return nil, err
+	}
+	return c.GetCSEThreatIntelIndicatorsInSource(id)
+}
+
+func cseThreatIntelIndicatorLine(indicator CSEThreatIntelIndicator) string {
+	return strings.Join([]string{indicator.Value, indicator.Type, strconv.Itoa(indicator.Confidence),
+		normalizeCSEMatchListItemExpiration(indicator.Expiration), indicator.Description}, "\t")
+}
+
+// validateCSEThreatIntelIndicator checks the fields of an indicator read from a file.
+func validateCSEThreatIntelIndicator(indicator CSEThreatIntelIndicator) error {
+	if indicator.Value == "" {
+		return fmt.Errorf("value is empty")
+	}
+	if !contains(cseThreatIntelIndicatorTypes, indicator.Type) {
+		return fmt.Errorf("unsupported type '%s' of indicator '%s', expected one of %s", indicator.Type,
+			indicator.Value, strings.Join(cseThreatIntelIndicatorTypes, ", "))
+	}
+	if indicator.Confidence < 0 || indicator.Confidence > 100 {
+		return fmt.Errorf("confidence of indicator '%s' must be between 0 and 100, got %d", indicator.Value, indicator.Confidence)
+	}
+	return nil
+}
+
+// parseCSEThreatIntelIndicatorsCsv reads indicators from CSV with a header row, with value and type
+// columns and optional confidence, expiration and description columns.
+func parseCSEThreatIntelIndicatorsCsv(r io.Reader) ([]CSEThreatIntelIndicator, error) {
+	return parseCSEBulkItemsCsv(r, []string{"value", "type"}, func(field func(string) string) (CSEThreatIntelIndicator, error) {
+		indicator := CSEThreatIntelIndicator{
+			Active:      true,
+			Value:       field("value"),
+			Type:        field("type"),
+			Expiration:  field("expiration"),
+			Description: field("description"),
+		}
+		if confidence := field("confidence"); confidence != "" {
+			var err error
+			indicator.Confidence, err = strconv.Atoi(confidence)
+			if err != nil {
+				return CSEThreatIntelIndicator{}, fmt.Errorf("invalid confidence '%s'", confidence)
+			}
+		}
+		return indicator, validateCSEThreatIntelIndicator(indicator)
+	})
+}
+
+// parseCSEThreatIntelIndicatorsJson reads indicators from a JSON array of objects with value, type,
+// confidence, expiration and description fields.
+func parseCSEThreatIntelIndicatorsJson(r io.Reader) ([]CSEThreatIntelIndicator, error) {
+	return parseCSEBulkItemsJson(r, func(indicator CSEThreatIntelIndicator) (CSEThreatIntelIndicator, error) {
+		indicator.ID = ""
+		indicator.Active = true
+		return indicator, validateCSEThreatIntelIndicator(indicator)
+	})
+}
+
+// stixIndicatorPattern matches the STIX patterns of indicators with a single comparison of an object
+// path with a string, such as [ipv4-addr:value = '198.51.100.1'].
+var stixIndicatorPattern = regexp.MustCompile(`^\[\s*([a-z0-9-]+:[A-Za-z0-9_.'-]+)\s*=\s*'((?:[^'\\]|\\.)*)'\s*\]$`)
+
+// parseCSEThreatIntelIndicatorsStix reads the indicators of a STIX 2.1 bundle. Objects that aren't
+// indicators are ignored, and the patterns of indicators must compare a single object path with a value.
+func parseCSEThreatIntelIndicatorsStix(r io.Reader) ([]CSEThreatIntelIndicator, error) {
+	var bundle struct {
+		Type    string `json:"type"`
+		Objects []struct {
+			Type        string `json:"type"`
+			ID          string `json:"id"`
+			Name        string `json:"name"`
+			Description string `json:"description"`
+			Pattern     string `json:"pattern"`
+			PatternType string `json:"pattern_type"`
+			Confidence  int    `json:"confidence"`
+			ValidUntil  string `json:"valid_until"`
+		} `json:"objects"`
+	}
+	err := json.NewDecoder(r).Decode(&bundle)
+	if err != nil {
+		return nil, err
+	}
+	if bundle.Type != "bundle" {
+		return nil, fmt.Errorf("expected a STIX bundle, got type '%s'", bundle.Type)
+	}
+
+	var indicators []CSEThreatIntelIndicator
+	for _, object := range bundle.Objects {
+		if object.Type != "indicator" {
+			continue
+		}
+		if object.PatternType != "" && object.PatternType != "stix" {
+			return nil, fmt.Errorf("%s: unsupported pattern type '%s'", object.ID, object.PatternType)
+		}
+		match := stixIndicatorPattern.FindStringSubmatch(strings.TrimSpace(object.Pattern))
+		if match == nil {
+			return nil, fmt.Errorf("%s: unsupported pattern %s, expected a single comparison like [ipv4-addr:value = '198.51.100.1']",
+				object.ID, object.Pattern)
+		}
+
+		description := object.Description
+		if description == "" {
+			description = object.Name
+		}
+		indicator := CSEThreatIntelIndicator{
+			Active:      true,
+			Value:       strings.NewReplacer(`\'`, `'`, `\\`, `\`).Replace(match[2]),
+			Type:        strings.ReplaceAll(match[1], "'", ""),
+			Confidence:  object.Confidence,
+			Expiration:  object.ValidUntil,
+			Description: description,
+		}
+		if err := validateCSEThreatIntelIndicator(indicator); err != nil {
+			return nil, fmt.Errorf("%s: %v", object.ID, err)
+		}
+		indicators = append(indicators, indicator)
+	}
+	return indicators, nil
+}
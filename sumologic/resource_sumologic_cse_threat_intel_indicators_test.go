@@ -0,0 +1,205 @@
+package sumologic
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDiffCSEThreatIntelIndicators(t *testing.T) {
+	existingIndicators := []CSEThreatIntelIndicator{
+		{ID: "1", Active: true, Value: "198.51.100.1", Type: "ipv4-addr:value", Confidence: 80},
+		{ID: "2", Active: true, Value: "evil.example.com", Type: "domain-name:value", Confidence: 80,
+			Expiration: "2030-01-01T00:00:00.000Z"},
+		{ID: "3", Active: true, Value: "198.51.100.3", Type: "ipv4-addr:value"},
+		{ID: "4", Active: true, Value: "198.51.100.4", Type: "ipv4-addr:value"},
+	}
+	indicators := []CSEThreatIntelIndicator{
+		{Active: true, Value: "198.51.100.1", Type: "ipv4-addr:value", Confidence: 90},
+		{Active: true, Value: "evil.example.com", Type: "domain-name:value", Confidence: 80,
+			Expiration: "2030-01-01T00:00:00Z"},
+		{Active: true, Value: "198.51.100.5", Type: "ipv4-addr:value"},
+	}
+	managedValues := schema.NewSet(schema.HashString, []interface{}{"198.51.100.1", "evil.example.com", "198.51.100.3"})
+
+	addIndicators, deleteIds := cseThreatIntelIndicatorsBulk.diff(existingIndicators, indicators, managedValues, false)
+	if !reflect.DeepEqual(addIndicators, []CSEThreatIntelIndicator{indicators[0], indicators[2]}) {
+		t.Errorf("expected the changed and the new indicators to be added, got %+v", addIndicators)
+	}
+	if !reflect.DeepEqual(deleteIds, []string{"1", "3"}) {
+		t.Errorf("expected the changed and the removed managed indicators to be deleted, got %v", deleteIds)
+	}
+
+	_, deleteIds = cseThreatIntelIndicatorsBulk.diff(existingIndicators, indicators, managedValues, true)
+	if !reflect.DeepEqual(deleteIds, []string{"1", "3", "4"}) {
+		t.Errorf("expected all the indicators that aren't configured to be deleted, got %v", deleteIds)
+	}
+}
+
+func TestParseCSEThreatIntelIndicatorsStix(t *testing.T) {
+	indicators, err := parseCSEThreatIntelIndicatorsStix(strings.NewReader(`{
+		"type": "bundle",
+		"id": "bundle--5d0092c5-5f74-4287-9642-33f4c354e56d",
+		"objects": [
+			{
+				"type": "indicator",
+				"id": "indicator--8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f",
+				"name": "C2 server",
+				"pattern": "[ipv4-addr:value = '198.51.100.1']",
+				"pattern_type": "stix",
+				"confidence": 85,
+				"valid_until": "2030-01-01T00:00:00Z"
+			},
+			{
+				"type": "indicator",
+				"id": "indicator--d81f86b9-975b-4c0b-875e-810c5ad45a4f",
+				"description": "Dropper",
+				"pattern": "[file:hashes.'SHA-256' = 'aec070645fe53ee3b3763059376134f058cc337247c978add178b6ccdfb0019f']"
+			},
+			{
+				"type": "malware",
+				"id": "malware--31b940d4-6f7f-459a-80ea-9c1f17b5891b",
+				"name": "Poison Ivy"
+			}
+		]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []CSEThreatIntelIndicator{
+		{Active: true, Value: "198.51.100.1", Type: "ipv4-addr:value", Confidence: 85,
+			Expiration: "2030-01-01T00:00:00Z", Description: "C2 server"},
+		{Active: true, Value: "aec070645fe53ee3b3763059376134f058cc337247c978add178b6ccdfb0019f",
+			Type: "file:hashes.SHA-256", Description: "Dropper"},
+	}
+	if !reflect.DeepEqual(indicators, expected) {
+		t.Errorf("expected indicators %+v, got %+v", expected, indicators)
+	}
+
+	_, err = parseCSEThreatIntelIndicatorsStix(strings.NewReader(`{"type": "bundle", "objects": [{
+		"type": "indicator",
+		"id": "indicator--1",
+		"pattern": "[ipv4-addr:value = '198.51.100.1' OR ipv4-addr:value = '198.51.100.2']"
+	}]}`))
+	if err == nil || !strings.Contains(err.Error(), "unsupported pattern") {
+		t.Errorf("expected an error for a pattern with several comparisons, got %v", err)
+	}
+}
+
+func TestParseCSEThreatIntelIndicatorsCsv(t *testing.T) {
+	indicators, err := parseCSEThreatIntelIndicatorsCsv(strings.NewReader("value,type,confidence,description\n" +
+		"198.51.100.1,ipv4-addr:value,85,C2 server\n" +
+		"evil.example.com,domain-name:value,,\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []CSEThreatIntelIndicator{
+		{Active: true, Value: "198.51.100.1", Type: "ipv4-addr:value", Confidence: 85, Description: "C2 server"},
+		{Active: true, Value: "evil.example.com", Type: "domain-name:value"},
+	}
+	if !reflect.DeepEqual(indicators, expected) {
+		t.Errorf("expected indicators %+v, got %+v", expected, indicators)
+	}
+
+	_, err = parseCSEThreatIntelIndicatorsCsv(strings.NewReader("value,type\n198.51.100.1,ip\n"))
+	if err == nil || !strings.Contains(err.Error(), "row 2: unsupported type 'ip'") {
+		t.Errorf("expected an error for an unsupported type, got %v", err)
+	}
+}
+
+func TestGetCSEThreatIntelIndicatorsInSource(t *testing.T) {
+	client, _ := newRoutingTestClient(map[string]string{
+		"sec/v1/threat-intel-indicators": `{"data": {"total": 1, "objects": [
+			{"id": "1", "active": true, "value": "198.51.100.1", "type": "ipv4-addr:value", "confidence": 85}
+		]}}`,
+	})
+
+	indicators, err := client.GetCSEThreatIntelIndicatorsInSource("0000000000000001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []CSEThreatIntelIndicator{
+		{ID: "1", Active: true, Value: "198.51.100.1", Type: "ipv4-addr:value", Confidence: 85},
+	}
+	if !reflect.DeepEqual(indicators, expected) {
+		t.Errorf("expected indicators %+v, got %+v", expected, indicators)
+	}
+
+}
+
+func TestListCSEThreatIntelIndicators(t *testing.T) {
+	client, _ := newRoutingTestClient(map[string]string{
+		"sec/v1/threat-intel-sources/0000000000000001": `{"data": {"id": "0000000000000001", "name": "iocs"}}`,
+		"sec/v1/threat-intel-indicators":               `{"data": {"total": 0, "objects": []}}`,
+	})
+
+	indicators, err := listCSEThreatIntelIndicators(client, "0000000000000001")
+	if err != nil || indicators == nil || len(indicators) != 0 {
+		t.Errorf("expected no indicators for an empty source, got %+v, %v", indicators, err)
+	}
+
+	// The indicators are searched by source, so only the source tells whether it exists.
+	indicators, err = listCSEThreatIntelIndicators(client, "0000000000000002")
+	if err != nil || indicators != nil {
+		t.Errorf("expected nil for a source that doesn't exist, got %+v, %v", indicators, err)
+	}
+}
+
+func TestAccSumologicCSEThreatIntelIndicators_basic(t *testing.T) {
+	SkipCseTest(t)
+
+	name := fmt.Sprintf("Terraform Indicators Test %s", acctest.RandString(8))
+	resourceName := "sumologic_cse_threat_intel_indicators.indicators"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCSEThreatIntelSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCSEThreatIntelIndicatorsConfig(name, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "indicator_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "managed_values.#", "3"),
+				),
+			},
+			{
+				Config: testCSEThreatIntelIndicatorsConfig(name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "indicator_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_values.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCSEThreatIntelIndicatorsConfig(name string, numIndicators int) string {
+	var indicatorsStr = ""
+	for i := 0; i < numIndicators; i++ {
+		indicatorsStr += fmt.Sprintf(`
+  indicators {
+    value       = "198.51.100.%d"
+    type        = "ipv4-addr:value"
+    confidence  = 80
+    description = "indicator %d"
+  }`, i, i)
+	}
+
+	return fmt.Sprintf(`
+resource "sumologic_cse_threat_intel_source" "threat_intel_source" {
+  name        = "%s"
+  description = "Threat intel source with indicators managed in bulk"
+}
+
+resource "sumologic_cse_threat_intel_indicators" "indicators" {
+  source_id = sumologic_cse_threat_intel_source.threat_intel_source.id
+  %s
+}
+`, name, indicatorsStr)
+}
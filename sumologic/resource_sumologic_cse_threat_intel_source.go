@@ -0,0 +1,118 @@
+package sumologic
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSumologicCSEThreatIntelSource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSumologicCSEThreatIntelSourceCreate,
+		Read:   resourceSumologicCSEThreatIntelSourceRead,
+		Delete: resourceSumologicCSEThreatIntelSourceDelete,
+		Update: resourceSumologicCSEThreatIntelSourceUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     false,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"source_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSumologicCSEThreatIntelSourceRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	id := d.Id()
+	cseThreatIntelSource, err := c.GetCSEThreatIntelSource(id)
+	if err != nil {
+		log.Printf("[WARN] CSE Threat Intel Source not found when looking by id: %s, err: %v", id, err)
+	}
+
+	if cseThreatIntelSource == nil {
+		log.Printf("[WARN] CSE Threat Intel Source not found, removing from state: %v - %v", id, err)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", cseThreatIntelSource.Name)
+	d.Set("description", cseThreatIntelSource.Description)
+	d.Set("source_type", cseThreatIntelSource.SourceType)
+	d.Set("created", cseThreatIntelSource.Created)
+	d.Set("created_by", cseThreatIntelSource.CreatedBy)
+	d.Set("last_updated", cseThreatIntelSource.LastUpdated)
+	d.Set("last_updated_by", cseThreatIntelSource.LastUpdatedBy)
+
+	return nil
+}
+
+func resourceSumologicCSEThreatIntelSourceDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	return c.DeleteCSEThreatIntelSource(d.Id())
+}
+
+func resourceSumologicCSEThreatIntelSourceCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	if d.Id() == "" {
+		id, err := c.CreateCSEThreatIntelSource(resourceToCSEThreatIntelSource(d))
+		if err != nil {
+			return fmt.Errorf("[ERROR] An error occurred creating threat intel source %s, err: %v", d.Get("name"), err)
+		}
+		d.SetId(id)
+	}
+
+	return resourceSumologicCSEThreatIntelSourceRead(d, meta)
+}
+
+func resourceSumologicCSEThreatIntelSourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	if err := c.UpdateCSEThreatIntelSource(d.Id(), resourceToCSEThreatIntelSource(d)); err != nil {
+		return fmt.Errorf("[ERROR] An error occurred updating threat intel source with id %s, err: %v", d.Id(), err)
+	}
+
+	return resourceSumologicCSEThreatIntelSourceRead(d, meta)
+}
+
+func resourceToCSEThreatIntelSource(d *schema.ResourceData) CSEThreatIntelSourcePost {
+	return CSEThreatIntelSourcePost{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+}
@@ -0,0 +1,118 @@
+package sumologic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSumologicCSEThreatIntelSource_createAndUpdate(t *testing.T) {
+	SkipCseTest(t)
+
+	var threatIntelSource CSEThreatIntelSourceGet
+	resourceName := "sumologic_cse_threat_intel_source.threat_intel_source"
+	nName := fmt.Sprintf("Terraform Threat Intel Test %s", acctest.RandString(8))
+	nDescription := "Threat intel source description"
+	uDescription := "Updated threat intel source description"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCSEThreatIntelSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateCSEThreatIntelSourceConfig(nName, nDescription),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckCSEThreatIntelSourceExists(resourceName, &threatIntelSource),
+					testCheckCSEThreatIntelSourceValues(&threatIntelSource, nName, nDescription),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			{
+				Config: testCreateCSEThreatIntelSourceConfig(nName, uDescription),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckCSEThreatIntelSourceExists(resourceName, &threatIntelSource),
+					testCheckCSEThreatIntelSourceValues(&threatIntelSource, nName, uDescription),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCSEThreatIntelSourceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sumologic_cse_threat_intel_source" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("CSE Threat Intel Source destruction check: CSE Threat Intel Source ID is not set")
+		}
+
+		s, err := client.GetCSEThreatIntelSource(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Encountered an error: %w", err)
+		}
+		if s != nil {
+			return fmt.Errorf("threat intel source still exists")
+		}
+	}
+	return nil
+}
+
+func testCreateCSEThreatIntelSourceConfig(nName string, nDescription string) string {
+	return fmt.Sprintf(`
+resource "sumologic_cse_threat_intel_source" "threat_intel_source" {
+	name = "%s"
+	description = "%s"
+}
+`, nName, nDescription)
+}
+
+func testCheckCSEThreatIntelSourceExists(n string, threatIntelSource *CSEThreatIntelSourceGet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("threat intel source ID is not set")
+		}
+
+		c := testAccProvider.Meta().(*Client)
+		threatIntelSourceResp, err := c.GetCSEThreatIntelSource(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if threatIntelSourceResp == nil {
+			return fmt.Errorf("threat intel source %s not found", rs.Primary.ID)
+		}
+
+		*threatIntelSource = *threatIntelSourceResp
+
+		return nil
+	}
+}
+
+func testCheckCSEThreatIntelSourceValues(threatIntelSource *CSEThreatIntelSourceGet, nName string, nDescription string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if threatIntelSource.Name != nName {
+			return fmt.Errorf("bad name, expected \"%s\", got: \"%s\"", nName, threatIntelSource.Name)
+		}
+		if threatIntelSource.Description != nDescription {
+			return fmt.Errorf("bad description, expected \"%s\", got: \"%s\"", nDescription, threatIntelSource.Description)
+		}
+		return nil
+	}
+}
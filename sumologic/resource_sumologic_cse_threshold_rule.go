@@ -1,6 +1,8 @@
 package sumologic
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"strings"
 
@@ -17,6 +19,9 @@ func resourceSumologicCSEThresholdRule() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return validateRuleRequiredFields(d, "threshold", nil, []string{"expression"})
+		},
 
 		Schema: map[string]*schema.Schema{
 			"count_distinct": {
@@ -39,7 +44,7 @@ func resourceSumologicCSEThresholdRule() *schema.Resource {
 			"entity_selectors": getEntitySelectorsSchema(),
 			"expression": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
 				DiffSuppressFunc: suppressSpaceDiff,
 			},
 			"group_by_fields": {
@@ -86,6 +91,9 @@ func resourceSumologicCSEThresholdRule() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"rule_id":           getBuiltInRuleIdSchema(),
+			"rule_source":       getRuleSourceSchema(),
+			"built_in_defaults": getBuiltInRuleDefaultsSchema(),
 			"suppression_window_size": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -113,12 +121,9 @@ func resourceSumologicCSEThresholdRuleRead(d *schema.ResourceData, meta interfac
 		return nil
 	}
 
-	d.Set("count_distinct", CSEThresholdRuleGet.CountDistinct)
-	d.Set("count_field", CSEThresholdRuleGet.CountField)
 	d.Set("description", CSEThresholdRuleGet.Description)
 	d.Set("enabled", CSEThresholdRuleGet.Enabled)
 	d.Set("entity_selectors", entitySelectorArrayToResource(CSEThresholdRuleGet.EntitySelectors))
-	d.Set("expression", CSEThresholdRuleGet.Expression)
 	d.Set("group_by_fields", CSEThresholdRuleGet.GroupByFields)
 	d.Set("is_prototype", CSEThresholdRuleGet.IsPrototype)
 	d.Set("limit", CSEThresholdRuleGet.Limit)
@@ -133,19 +138,46 @@ func resourceSumologicCSEThresholdRuleRead(d *schema.ResourceData, meta interfac
 	if CSEThresholdRuleGet.SuppressionWindowSize != nil {
 		d.Set("suppression_window_size", CSEThresholdRuleGet.SuppressionWindowSize)
 	}
-	return nil
+
+	if readAllRuleFields(d, CSEThresholdRuleGet.RuleSource) {
+		d.Set("count_distinct", CSEThresholdRuleGet.CountDistinct)
+		d.Set("count_field", CSEThresholdRuleGet.CountField)
+		d.Set("expression", CSEThresholdRuleGet.Expression)
+	}
+	d.Set("rule_source", CSEThresholdRuleGet.RuleSource)
+
+	return setBuiltInRuleDefaults(d, CSEThresholdRuleGet.RuleSource, CSEThresholdRuleGet.Enabled)
 }
 
 func resourceSumologicCSEThresholdRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	c := meta.(*Client)
 
-	return c.DeleteCSEThresholdRule(d.Id())
+	if isBuiltInRule(d.Get("rule_source").(string)) {
+		return restoreBuiltInRule(d, c, "threshold")
+	}
 
+	return c.DeleteCSEThresholdRule(d.Id())
 }
 
 func resourceSumologicCSEThresholdRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	c := meta.(*Client)
 
+	if ruleId, ok := d.GetOk("rule_id"); ok && d.Id() == "" {
+		CSEThresholdRuleGet, err := c.GetCSEThresholdRule(ruleId.(string))
+		if err != nil {
+			return err
+		}
+		if CSEThresholdRuleGet == nil {
+			return fmt.Errorf("CSE Threshold Rule with id %s does not exist", ruleId)
+		}
+
+		err = adoptBuiltInRule(d, ruleId.(string), CSEThresholdRuleGet.RuleSource, CSEThresholdRuleGet.Enabled)
+		if err != nil {
+			return err
+		}
+		return resourceSumologicCSEThresholdRuleUpdate(d, meta)
+	}
+
 	if d.Id() == "" {
 
 		var suppressionWindowSize *int = nil
@@ -196,6 +228,9 @@ func resourceSumologicCSEThresholdRuleUpdate(d *schema.ResourceData, meta interf
 		err = c.UpdateCSEThresholdRule(CSEThresholdRule)
 	} else {
 		err = c.OverrideCSEThresholdRule(CSEThresholdRule)
+		if err == nil {
+			err = updateBuiltInRuleEnabled(d, c)
+		}
 	}
 
 	if err != nil {
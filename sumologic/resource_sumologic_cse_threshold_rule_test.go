@@ -2,6 +2,8 @@ package sumologic
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
@@ -103,6 +105,96 @@ func TestAccSumologicCSEThresholdRule_Override(t *testing.T) {
 	})
 }
 
+func TestResourceSumologicCSEThresholdRule_builtIn(t *testing.T) {
+	client, httpClient := newRoutingTestClient(map[string]string{
+		"sec/v1/rules/THRESHOLD-S00059": `{"data": {
+			"id": "THRESHOLD-S00059",
+			"name": "Network Share Scan",
+			"description": "Detects multiple network share access attempts",
+			"enabled": false,
+			"expression": "metadata_deviceEventId = 'Security-5140'",
+			"limit": 25,
+			"score": 1,
+			"windowSize": 300000,
+			"windowSizeName": "T05M",
+			"ruleSource": "sumo"
+		}}`,
+		"sec/v1/rules/threshold/THRESHOLD-S00059/override": `{"data": {}}`,
+		"sec/v1/rules/THRESHOLD-S00059/enabled":            `{"data": {}}`,
+	})
+	d := resourceSumologicCSEThresholdRule().Data(nil)
+	d.MarkNewResource()
+	d.Set("rule_id", "THRESHOLD-S00059")
+	d.Set("name", "Network Share Scan")
+	d.Set("description", "Overridden description")
+	d.Set("enabled", true)
+	d.Set("expression", "configured expression")
+	d.Set("limit", 25)
+	d.Set("severity", 5)
+	d.Set("window_size", "T05M")
+
+	err := resourceSumologicCSEThresholdRuleCreate(d, client)
+	assert.NoError(t, err)
+	assert.Equal(t, "THRESHOLD-S00059", d.Id())
+	assert.Equal(t, "sumo", d.Get("rule_source"))
+	// Only the overridable fields are read, the others keep their configured values.
+	assert.Equal(t, "configured expression", d.Get("expression"))
+	assert.Equal(t, "Network Share Scan", d.Get("name"))
+	assert.Contains(t, httpClient.methods, "PUT sec/v1/rules/threshold/THRESHOLD-S00059/override")
+	assert.Contains(t, httpClient.methods, "PUT sec/v1/rules/THRESHOLD-S00059/enabled")
+
+	var defaults CSEBuiltInRuleDefaults
+	assert.NoError(t, json.Unmarshal([]byte(d.Get("built_in_defaults").(string)), &defaults))
+	assert.False(t, defaults.Enabled)
+
+	httpClient.methods = nil
+	err = resourceSumologicCSEThresholdRuleDelete(d, client)
+	assert.NoError(t, err)
+	// The rule isn't deleted, its override is reverted and its enabled state restored instead.
+	assert.NotContains(t, httpClient.methods, "DELETE sec/v1/rules/THRESHOLD-S00059")
+	assert.NotContains(t, httpClient.methods, "PUT sec/v1/rules/threshold/THRESHOLD-S00059/override")
+	assert.Subset(t, httpClient.methods, []string{
+		"DELETE sec/v1/rules/threshold/THRESHOLD-S00059/override",
+		"PUT sec/v1/rules/THRESHOLD-S00059/enabled",
+	}, httpClient.methods)
+	assert.JSONEq(t, `{"enabled": false}`, httpClient.requestBodies["PUT sec/v1/rules/THRESHOLD-S00059/enabled"])
+}
+
+func TestResourceSumologicCSEThresholdRule_builtInRequiredFields(t *testing.T) {
+	r := resourceSumologicCSEThresholdRule()
+	config := map[string]interface{}{
+		"name":        "Network Share Scan",
+		"description": "Overridden description",
+		"enabled":     true,
+		"entity_selectors": []interface{}{
+			map[string]interface{}{"entity_type": "_ip", "expression": "srcDevice_ip"},
+		},
+		"limit":       25,
+		"severity":    5,
+		"window_size": "T05M",
+	}
+
+	_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+	assert.ErrorContains(t, err, `"expression" is required when creating a new threshold rule`)
+
+	// The expression of a built-in rule can't be overridden, so it isn't required to adopt the rule.
+	config["rule_id"] = "THRESHOLD-S00059"
+	_, err = r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+	assert.NoError(t, err)
+}
+
+func TestResourceSumologicCSEThresholdRule_builtInUserRule(t *testing.T) {
+	client, _ := newRoutingTestClient(map[string]string{
+		"sec/v1/rules/THRESHOLD-U00001": `{"data": {"id": "THRESHOLD-U00001", "ruleSource": "user"}}`,
+	})
+	d := resourceSumologicCSEThresholdRule().Data(nil)
+	d.Set("rule_id", "THRESHOLD-U00001")
+
+	err := resourceSumologicCSEThresholdRuleCreate(d, client)
+	assert.ErrorContains(t, err, "not a built-in rule")
+	assert.Equal(t, "", d.Id())
+}
+
 func getThresholdRuleRemovedBlock() string {
 	return fmt.Sprintf(`
 	removed {
@@ -1,12 +1,25 @@
 package sumologic
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"log"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// queryPanelTypes maps the blocks of the panels defined by queries, but no other settings, to their panel types.
+var queryPanelTypes = map[string]string{
+	"honeycomb_panel":        "HoneycombPanel",
+	"map_panel":              "MapPanel",
+	"metrics_explorer_panel": "MetricsExplorerPanel",
+}
+
 var (
 	validMetricsAggregationDataValues = []string{
 		"Count",
@@ -27,6 +40,7 @@ func resourceSumologicDashboard() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceSumologicDashboardCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"title": {
@@ -96,6 +110,13 @@ func resourceSumologicDashboard() *schema.Resource {
 					Schema: getPanelSchema(),
 				},
 			},
+			"from_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentDashboardJsonDiff,
+				ConflictsWith:    []string{"panel", "layout", "variable", "auto_layout"},
+			},
 			"layout": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -104,6 +125,15 @@ func resourceSumologicDashboard() *schema.Resource {
 					Schema: getLayoutSchema(),
 				},
 			},
+			"auto_layout": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"layout", "from_json"},
+				Elem: &schema.Resource{
+					Schema: getAutoLayoutSchema(),
+				},
+			},
 			"variable": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -163,6 +193,38 @@ func getPanelSchema() map[string]*schema.Schema {
 				Schema: getServiceMapPanelSchema(),
 			},
 		},
+		"honeycomb_panel": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: getQueryPanelSchema(),
+			},
+		},
+		"map_panel": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: getQueryPanelSchema(),
+			},
+		},
+		"metrics_explorer_panel": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: getQueryPanelSchema(),
+			},
+		},
+		"links_panel": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: getLinksPanelSchema(),
+			},
+		},
 	}
 }
 
@@ -181,8 +243,10 @@ func getPanelBaseSchema() map[string]*schema.Schema {
 			Optional: true,
 		},
 		"visual_settings": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: structure.SuppressJsonDiff,
 		},
 		"keep_visual_settings_consistent_with_parent": {
 			Type:     schema.TypeBool,
@@ -212,6 +276,14 @@ func getSumoSearchPanelSchema() map[string]*schema.Schema {
 	panelSchema := getPanelBaseSchema()
 
 	searchPanelSchema := map[string]*schema.Schema{
+		visualSettingsConfigFieldName: {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: getVisualSettingsConfigSchema(),
+			},
+		},
 		"query": {
 			Type:     schema.TypeList,
 			Optional: true,
@@ -333,6 +405,75 @@ func getServiceMapPanelSchema() map[string]*schema.Schema {
 	return panelSchema
 }
 
+func getQueryPanelSchema() map[string]*schema.Schema {
+	panelSchema := getPanelBaseSchema()
+
+	queryPanelSchema := map[string]*schema.Schema{
+		"query": {
+			Type:     schema.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Resource{
+				Schema: getSumoSearchPanelQuerySchema(),
+			},
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"time_range": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: GetTimeRangeSchema(),
+			},
+		},
+	}
+
+	for k, v := range queryPanelSchema {
+		panelSchema[k] = v
+	}
+
+	return panelSchema
+}
+
+func getLinksPanelSchema() map[string]*schema.Schema {
+	panelSchema := getPanelBaseSchema()
+
+	linksPanelSchema := map[string]*schema.Schema{
+		"link": {
+			Type:     schema.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"title": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"url": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+					"description": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+
+	for k, v := range linksPanelSchema {
+		panelSchema[k] = v
+	}
+
+	return panelSchema
+}
+
 func getSumoSearchPanelQuerySchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"query_string": {
@@ -583,6 +724,25 @@ func getSourceDefinitionSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"lookup_table_variable_source_definition": {
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"lookup_table_id": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"field": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 65536),
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -623,7 +783,7 @@ func getColoringRulesSchema() map[string]*schema.Schema {
 	}
 }
 
-func resourceToDashboard(d *schema.ResourceData) Dashboard {
+func resourceToDashboard(d *schema.ResourceData) (Dashboard, error) {
 	var timeRange interface{}
 	if val, ok := d.GetOk("time_range"); ok {
 		tfTimeRange := val.([]interface{})[0]
@@ -640,7 +800,10 @@ func resourceToDashboard(d *schema.ResourceData) Dashboard {
 	if val, ok := d.GetOk("panel"); ok {
 		tfPanels := val.([]interface{})
 		for _, tfPanel := range tfPanels {
-			panel := getPanel(tfPanel.(map[string]interface{}))
+			panel, err := getPanel(tfPanel.(map[string]interface{}))
+			if err != nil {
+				return Dashboard{}, err
+			}
 			panels = append(panels, panel)
 		}
 	}
@@ -660,6 +823,20 @@ func resourceToDashboard(d *schema.ResourceData) Dashboard {
 		}
 	}
 
+	if fromJson, ok := d.GetOk("from_json"); ok {
+		definition, err := expandDashboardJson(fromJson.(string))
+		if err != nil {
+			return Dashboard{}, err
+		}
+		panels = definition.Panels
+		layout = definition.Layout
+		variables = definition.Variables
+	}
+
+	if tfAutoLayout, ok := getAutoLayoutConfig(d.Get("auto_layout").([]interface{})); ok {
+		layout = getAutoLayout(getPanelKeys(panels), tfAutoLayout)
+	}
+
 	var coloringRules []ColoringRule
 	if val, ok := d.GetOk("coloring_rule"); ok {
 		tfColoringRules := val.([]interface{})
@@ -683,28 +860,124 @@ func resourceToDashboard(d *schema.ResourceData) Dashboard {
 		Variables:        variables,
 		Theme:            d.Get("theme").(string),
 		ColoringRules:    coloringRules,
+	}, nil
+}
+
+// dashboardDefinition is the part of a dashboard that can be specified with from_json.
+type dashboardDefinition struct {
+	Panels    []interface{} `json:"panels"`
+	Layout    interface{}   `json:"layout"`
+	Variables []Variable    `json:"variables"`
+}
+
+// expandDashboardJson converts the panels, layout and variables of a dashboard in the JSON format of the
+// API, e.g. exported from the UI, through the panel, layout and variable blocks. This way they are
+// normalized the same way as dashboards defined in HCL.
+func expandDashboardJson(value string) (*dashboardDefinition, error) {
+	var dashboard Dashboard
+	if err := json.Unmarshal([]byte(value), &dashboard); err != nil {
+		return nil, fmt.Errorf("invalid from_json: %s", err)
+	}
+	return normalizeDashboardDefinition(&dashboard)
+}
+
+func normalizeDashboardDefinition(dashboard *Dashboard) (*dashboardDefinition, error) {
+	d := resourceSumologicDashboard().Data(nil)
+	panels, err := getTerraformPanels(dashboard.Panels, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("panel", panels); err != nil {
+		return nil, err
+	}
+	if dashboard.Layout != nil {
+		layout, err := dashboardJsonObject(dashboard.Layout, "layout")
+		if err != nil {
+			return nil, err
+		}
+		tfLayout, err := getTerraformLayout(layout)
+		if err != nil {
+			return nil, err
+		}
+		if err := d.Set("layout", tfLayout); err != nil {
+			return nil, err
+		}
 	}
+	variables, err := getTerraformVariables(dashboard.Variables)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("variable", variables); err != nil {
+		return nil, err
+	}
+
+	expanded, err := resourceToDashboard(d)
+	if err != nil {
+		return nil, err
+	}
+	return &dashboardDefinition{
+		Panels:    expanded.Panels,
+		Layout:    expanded.Layout,
+		Variables: expanded.Variables,
+	}, nil
+}
+
+func suppressEquivalentDashboardJsonDiff(k, old, new string, d *schema.ResourceData) bool {
+	oldDefinition, err := expandDashboardJson(old)
+	if err != nil {
+		return false
+	}
+	newDefinition, err := expandDashboardJson(new)
+	if err != nil {
+		return false
+	}
+	oldJson, _ := json.Marshal(oldDefinition)
+	newJson, _ := json.Marshal(newDefinition)
+	return string(oldJson) == string(newJson)
 }
 
-func getPanel(tfPanel map[string]interface{}) interface{} {
+func getPanel(tfPanel map[string]interface{}) (interface{}, error) {
+	for block, panelType := range queryPanelTypes {
+		if val, ok := tfPanel[block].([]interface{}); ok && len(val) == 1 {
+			if tfQueryPanel, ok := val[0].(map[string]interface{}); ok {
+				return getQueryPanel(tfQueryPanel, panelType), nil
+			}
+		}
+	}
+	if val, ok := tfPanel["links_panel"].([]interface{}); ok && len(val) == 1 {
+		if tfLinksPanel, ok := val[0].(map[string]interface{}); ok {
+			return getLinksPanel(tfLinksPanel), nil
+		}
+	}
+
 	if val := tfPanel["text_panel"].([]interface{}); len(val) == 1 {
 		if tfTextPanel, ok := val[0].(map[string]interface{}); ok {
-			return getTextPanel(tfTextPanel)
+			return getTextPanel(tfTextPanel), nil
 		}
 	} else if val := tfPanel["sumo_search_panel"].([]interface{}); len(val) == 1 {
 		if tfSearchPanel, ok := val[0].(map[string]interface{}); ok {
-			return getSumoSearchPanel(tfSearchPanel)
+			return getSumoSearchPanel(tfSearchPanel), nil
 		}
 	} else if val := tfPanel["traces_list_panel"].([]interface{}); len(val) == 1 {
 		if tfTracesListPanel, ok := val[0].(map[string]interface{}); ok {
-			return getTracesListPanel(tfTracesListPanel)
+			return getTracesListPanel(tfTracesListPanel), nil
 		}
 	} else if val := tfPanel["service_map_panel"].([]interface{}); len(val) == 1 {
 		if tfServiceMapPanel, ok := val[0].(map[string]interface{}); ok {
-			return getServiceMapPanel(tfServiceMapPanel)
+			return getServiceMapPanel(tfServiceMapPanel), nil
 		}
 	}
-	return nil
+	return nil, fmt.Errorf("panel must contain exactly one of %s", strings.Join(getPanelBlockNames(), ", "))
+}
+
+// getPanelBlockNames returns the names of the blocks of the supported panel types.
+func getPanelBlockNames() []string {
+	var names []string
+	for name := range getPanelSchema() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
 func getTextPanel(tfTextPanel map[string]interface{}) interface{} {
@@ -738,6 +1011,14 @@ func getSumoSearchPanel(tfSearchPanel map[string]interface{}) interface{} {
 	if visualSettings, ok := tfSearchPanel["visual_settings"].(string); ok {
 		searchPanel.VisualSettings = visualSettings
 	}
+	if tfConfig, ok := tfSearchPanel[visualSettingsConfigFieldName].([]interface{}); ok {
+		// Invalid settings are already rejected at plan time, so keep the raw JSON in case of an error.
+		if visualSettings, err := getVisualSettings(searchPanel.VisualSettings, tfConfig); err == nil {
+			searchPanel.VisualSettings = visualSettings
+		} else {
+			log.Printf("[WARN] Failed to merge %s of panel %s: %v", visualSettingsConfigFieldName, searchPanel.Key, err)
+		}
+	}
 	if consistentVisualSettings, ok := tfSearchPanel["keep_visual_settings_consistent_with_parent"].(bool); ok {
 		searchPanel.KeepVisualSettingsConsistentWithParent = consistentVisualSettings
 	}
@@ -836,6 +1117,70 @@ func getServiceMapPanel(tfPanel map[string]interface{}) interface{} {
 	return panel
 }
 
+func getQueryPanel(tfPanel map[string]interface{}, panelType string) interface{} {
+	var panel QueryPanel
+	panel.PanelType = panelType
+
+	panel.Key = tfPanel["key"].(string)
+	if title, ok := tfPanel["title"].(string); ok {
+		panel.Title = title
+	}
+	if visualSettings, ok := tfPanel["visual_settings"].(string); ok {
+		panel.VisualSettings = visualSettings
+	}
+	if consistentVisualSettings, ok := tfPanel["keep_visual_settings_consistent_with_parent"].(bool); ok {
+		panel.KeepVisualSettingsConsistentWithParent = consistentVisualSettings
+	}
+
+	// query panel specific properties
+	if description, ok := tfPanel["description"].(string); ok {
+		panel.Description = description
+	}
+	if val := tfPanel["time_range"].([]interface{}); len(val) == 1 {
+		panel.TimeRange = GetTimeRange(val[0].(map[string]interface{}))
+	}
+	tfQueries := tfPanel["query"].([]interface{})
+	var queries []SearchPanelQuery
+	for _, tfQuery := range tfQueries {
+		query := getSearchPanelQuery(tfQuery.(map[string]interface{}))
+		queries = append(queries, query)
+	}
+	panel.Queries = queries
+
+	return panel
+}
+
+func getLinksPanel(tfPanel map[string]interface{}) interface{} {
+	var panel LinksPanel
+	panel.PanelType = "LinksPanel"
+
+	panel.Key = tfPanel["key"].(string)
+	if title, ok := tfPanel["title"].(string); ok {
+		panel.Title = title
+	}
+	if visualSettings, ok := tfPanel["visual_settings"].(string); ok {
+		panel.VisualSettings = visualSettings
+	}
+	if consistentVisualSettings, ok := tfPanel["keep_visual_settings_consistent_with_parent"].(bool); ok {
+		panel.KeepVisualSettingsConsistentWithParent = consistentVisualSettings
+	}
+
+	// links panel specific properties
+	tfLinks := tfPanel["link"].([]interface{})
+	var links []PanelLink
+	for _, val := range tfLinks {
+		tfLink := val.(map[string]interface{})
+		links = append(links, PanelLink{
+			Title:       tfLink["title"].(string),
+			Url:         tfLink["url"].(string),
+			Description: tfLink["description"].(string),
+		})
+	}
+	panel.Links = links
+
+	return panel
+}
+
 func getSearchPanelQuery(tfQuery map[string]interface{}) SearchPanelQuery {
 	var query SearchPanelQuery
 
@@ -1033,6 +1378,13 @@ func getSourceDefinition(tfSourceDef map[string]interface{}) interface{} {
 			VariableSourceType: "CsvVariableSourceDefinition",
 			Values:             csvSourceDef["values"].(string),
 		}
+	} else if val := tfSourceDef["lookup_table_variable_source_definition"].([]interface{}); len(val) == 1 {
+		lookupTableSourceDef := val[0].(map[string]interface{})
+		return LookupTableVariableSourceDefinition{
+			VariableSourceType: "LookupTableVariableSourceDefinition",
+			LookupTableId:      lookupTableSourceDef["lookup_table_id"].(string),
+			Field:              lookupTableSourceDef["field"].(string),
+		}
 	}
 	return nil
 }
@@ -1106,24 +1458,60 @@ func setDashboard(d *schema.ResourceData, dashboard *Dashboard) error {
 		return err
 	}
 
-	timeRange := GetTerraformTimeRange(dashboard.TimeRange.(map[string]interface{}))
-	if err := d.Set("time_range", timeRange); err != nil {
+	timeRange, err := getTerraformDashboardTimeRange(dashboard.TimeRange, "timeRange")
+	if err != nil {
 		return err
 	}
-
-	panels := getTerraformPanels(dashboard.Panels)
-	if err := d.Set("panel", panels); err != nil {
+	if err := d.Set("time_range", timeRange); err != nil {
 		return err
 	}
 
-	layout := getTerraformLayout(dashboard.Layout.(map[string]interface{}))
-	if err := d.Set("layout", layout); err != nil {
-		return err
-	}
+	// Dashboards defined with from_json keep their panels, layout and variables in from_json instead.
+	if d.Get("from_json").(string) != "" {
+		definition, err := normalizeDashboardDefinition(dashboard)
+		if err != nil {
+			return err
+		}
+		fromJson, err := json.Marshal(definition)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("from_json", string(fromJson)); err != nil {
+			return err
+		}
+	} else {
+		panels, err := getTerraformPanels(dashboard.Panels, getVisualSettingsConfigPanelKeys(d.Get("panel").([]interface{})))
+		if err != nil {
+			return err
+		}
+		if err := d.Set("panel", panels); err != nil {
+			return err
+		}
 
-	variables := getTerraformVariables(dashboard.Variables)
-	if err := d.Set("variable", variables); err != nil {
-		return err
+		// With auto_layout, the layout is only kept in the state if it differs from the computed one, so
+		// that the difference shows up in the plan.
+		var layout []map[string]interface{}
+		apiLayout, err := dashboardJsonObject(dashboard.Layout, "layout")
+		if err != nil {
+			return err
+		}
+		tfAutoLayout, hasAutoLayout := getAutoLayoutConfig(d.Get("auto_layout").([]interface{}))
+		if !hasAutoLayout || !isEquivalentGridLayout(apiLayout, getAutoLayout(getPanelKeys(dashboard.Panels), tfAutoLayout)) {
+			if layout, err = getTerraformLayout(apiLayout); err != nil {
+				return err
+			}
+		}
+		if err := d.Set("layout", layout); err != nil {
+			return err
+		}
+
+		variables, err := getTerraformVariables(dashboard.Variables)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("variable", variables); err != nil {
+			return err
+		}
 	}
 
 	coloringRules := getTerraformColoringRules(dashboard.ColoringRules)
@@ -1167,26 +1555,210 @@ func getTerraformTopologyLabel(topologyLabel *TopologyLabel) []map[string]interf
 	return tfTopologyLabel
 }
 
-func getTerraformPanels(panels []interface{}) []map[string]interface{} {
+// getVisualSettingsConfigPanelKeys returns the keys of the search panels that use visual_settings_config.
+func getVisualSettingsConfigPanelKeys(tfPanels []interface{}) map[string]bool {
+	keys := make(map[string]bool)
+	for _, val := range tfPanels {
+		tfPanel, ok := val.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if searchPanels := tfPanel["sumo_search_panel"].([]interface{}); len(searchPanels) == 1 {
+			tfSearchPanel := searchPanels[0].(map[string]interface{})
+			if tfConfig := tfSearchPanel[visualSettingsConfigFieldName].([]interface{}); len(tfConfig) > 0 {
+				keys[tfSearchPanel["key"].(string)] = true
+			}
+		}
+	}
+	return keys
+}
+
+// getTerraformPanels converts the panels returned by the API. The visual settings of the search panels
+// whose keys are in visualSettingsConfigKeys are split into visual_settings_config and visual_settings.
+func getTerraformPanels(panels []interface{}, visualSettingsConfigKeys map[string]bool) ([]map[string]interface{}, error) {
 	tfPanels := make([]map[string]interface{}, len(panels))
 
+	queryPanelBlocks := make(map[string]string)
+	for block, panelType := range queryPanelTypes {
+		queryPanelBlocks[panelType] = block
+	}
+
 	for i, val := range panels {
-		panel := val.(map[string]interface{})
+		panel, err := dashboardJsonObject(val, fmt.Sprintf("panel %d", i))
+		if err != nil {
+			return nil, err
+		}
 
 		tfPanel := map[string]interface{}{}
-		if panel["panelType"] == "TextPanel" {
+		panelType, _ := panel["panelType"].(string)
+		var tfBlock TerraformObject
+		if block, ok := queryPanelBlocks[panelType]; ok {
+			tfBlock, err = getTerraformQueryPanel(panel)
+			tfPanel[block] = tfBlock
+		} else if panelType == "LinksPanel" {
+			tfBlock, err = getTerraformLinksPanel(panel)
+			tfPanel["links_panel"] = tfBlock
+		} else if panelType == "TextPanel" {
 			tfPanel["text_panel"] = getTerraformTextPanel(panel)
-		} else if panel["panelType"] == "SumoSearchPanel" {
-			tfPanel["sumo_search_panel"] = getTerraformSearchPanel(panel)
-		} else if panel["panelType"] == "TracesListPanel" {
-			tfPanel["traces_list_panel"] = getTerraformTracesListPanel(panel)
-		} else if panel["panelType"] == "ServiceMapPanel" {
+		} else if panelType == "SumoSearchPanel" {
+			key, _ := panel["key"].(string)
+			tfBlock, err = getTerraformSearchPanel(panel, visualSettingsConfigKeys[key])
+			tfPanel["sumo_search_panel"] = tfBlock
+		} else if panelType == "TracesListPanel" {
+			tfBlock, err = getTerraformTracesListPanel(panel)
+			tfPanel["traces_list_panel"] = tfBlock
+		} else if panelType == "ServiceMapPanel" {
 			tfPanel["service_map_panel"] = getTerraformServiceMapPanel(panel)
+		} else {
+			// Dropping the panel would remove it from the dashboard on the next apply.
+			return nil, fmt.Errorf("panel %v has unsupported panel type %q", panel["key"], panelType)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid panel %v: %v", panel["key"], err)
 		}
 
 		tfPanels[i] = tfPanel
 	}
-	return tfPanels
+	return tfPanels, nil
+}
+
+// dashboardJsonObject checks that a value of the dashboard JSON, which is decoded into generic maps and
+// lists, is an object. The dashboard JSON comes from the API or from from_json.
+func dashboardJsonObject(value interface{}, name string) (map[string]interface{}, error) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be an object, got %s", name, dashboardJsonTypeName(value))
+	}
+	return object, nil
+}
+
+// dashboardJsonList checks that a value of the dashboard JSON is a list, a missing value being empty.
+func dashboardJsonList(value interface{}, name string) ([]interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be a list, got %s", name, dashboardJsonTypeName(value))
+	}
+	return list, nil
+}
+
+// dashboardJsonObjects checks that a value of the dashboard JSON is a list of objects.
+func dashboardJsonObjects(value interface{}, name string) ([]map[string]interface{}, error) {
+	list, err := dashboardJsonList(value, name)
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]map[string]interface{}, len(list))
+	for i, val := range list {
+		if objects[i], err = dashboardJsonObject(val, fmt.Sprintf("%s[%d]", name, i)); err != nil {
+			return nil, err
+		}
+	}
+	return objects, nil
+}
+
+func dashboardJsonTypeName(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "a string"
+	case float64:
+		return "a number"
+	case bool:
+		return "a boolean"
+	case []interface{}:
+		return "a list"
+	case map[string]interface{}:
+		return "an object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+// getTerraformDashboardTimeRange converts a time range of the dashboard JSON after checking its boundaries.
+func getTerraformDashboardTimeRange(value interface{}, name string) ([]map[string]interface{}, error) {
+	timeRange, err := dashboardJsonObject(value, name)
+	if err != nil {
+		return nil, err
+	}
+	if timeRange["type"] == "BeginBoundedTimeRange" {
+		if _, err := dashboardJsonObject(timeRange["from"], name+".from"); err != nil {
+			return nil, err
+		}
+		if to := timeRange["to"]; to != nil {
+			if _, err := dashboardJsonObject(to, name+".to"); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return GetTerraformTimeRange(timeRange), nil
+}
+
+func getTerraformQueryPanel(panel map[string]interface{}) (TerraformObject, error) {
+	tfQueryPanel := MakeTerraformObject()
+
+	tfQueryPanel[0]["key"] = panel["key"]
+	if title, ok := panel["title"]; ok {
+		tfQueryPanel[0]["title"] = title
+	}
+	if visualSettings, ok := panel["visualSettings"]; ok {
+		tfQueryPanel[0]["visual_settings"] = visualSettings
+	}
+	if keepVisualSettingsConsistentWithParent, ok := panel["keepVisualSettingsConsistentWithParent"]; ok {
+		tfQueryPanel[0]["keep_visual_settings_consistent_with_parent"] = keepVisualSettingsConsistentWithParent
+	}
+
+	queries, err := getTerraformSearchPanelQuery(panel["queries"])
+	if err != nil {
+		return TerraformObject{}, err
+	}
+	if queries != nil {
+		tfQueryPanel[0]["query"] = queries
+	}
+	if description, ok := panel["description"]; ok {
+		tfQueryPanel[0]["description"] = description
+	}
+	if timeRange := panel["timeRange"]; timeRange != nil {
+		if tfQueryPanel[0]["time_range"], err = getTerraformDashboardTimeRange(timeRange, "timeRange"); err != nil {
+			return TerraformObject{}, err
+		}
+	}
+
+	return tfQueryPanel, nil
+}
+
+func getTerraformLinksPanel(panel map[string]interface{}) (TerraformObject, error) {
+	tfLinksPanel := MakeTerraformObject()
+
+	tfLinksPanel[0]["key"] = panel["key"]
+	if title, ok := panel["title"]; ok {
+		tfLinksPanel[0]["title"] = title
+	}
+	if visualSettings, ok := panel["visualSettings"]; ok {
+		tfLinksPanel[0]["visual_settings"] = visualSettings
+	}
+	if keepVisualSettingsConsistentWithParent, ok := panel["keepVisualSettingsConsistentWithParent"]; ok {
+		tfLinksPanel[0]["keep_visual_settings_consistent_with_parent"] = keepVisualSettingsConsistentWithParent
+	}
+
+	links, err := dashboardJsonObjects(panel["links"], "links")
+	if err != nil {
+		return TerraformObject{}, err
+	}
+	tfLinks := make([]map[string]interface{}, len(links))
+	for i, link := range links {
+		tfLinks[i] = map[string]interface{}{
+			"title":       link["title"],
+			"url":         link["url"],
+			"description": link["description"],
+		}
+	}
+	tfLinksPanel[0]["link"] = tfLinks
+
+	return tfLinksPanel, nil
 }
 
 func getTerraformTextPanel(textPanel map[string]interface{}) TerraformObject {
@@ -1207,7 +1779,7 @@ func getTerraformTextPanel(textPanel map[string]interface{}) TerraformObject {
 	return tfTextPanel
 }
 
-func getTerraformSearchPanel(searchPanel map[string]interface{}) TerraformObject {
+func getTerraformSearchPanel(searchPanel map[string]interface{}, useVisualSettingsConfig bool) (TerraformObject, error) {
 	tfSearchPanel := MakeTerraformObject()
 
 	tfSearchPanel[0]["key"] = searchPanel["key"]
@@ -1216,29 +1788,47 @@ func getTerraformSearchPanel(searchPanel map[string]interface{}) TerraformObject
 	}
 	if visualSettings, ok := searchPanel["visualSettings"]; ok {
 		tfSearchPanel[0]["visual_settings"] = visualSettings
+		if visualSettings, ok := visualSettings.(string); ok && useVisualSettingsConfig {
+			remainder, tfConfig, err := getTerraformVisualSettings(visualSettings)
+			if err == nil {
+				tfSearchPanel[0]["visual_settings"] = remainder
+				tfSearchPanel[0][visualSettingsConfigFieldName] = tfConfig
+			} else {
+				log.Printf("[WARN] Failed to read %s of panel %s: %v", visualSettingsConfigFieldName, searchPanel["key"], err)
+			}
+		}
 	}
 	if keepVisualSettingsConsistentWithParent, ok := searchPanel["keepVisualSettingsConsistentWithParent"]; ok {
 		tfSearchPanel[0]["keep_visual_settings_consistent_with_parent"] = keepVisualSettingsConsistentWithParent
 	}
 
-	tfSearchPanel[0]["query"] = getTerraformSearchPanelQuery(searchPanel["queries"].([]interface{}))
+	var err error
+	if tfSearchPanel[0]["query"], err = getTerraformSearchPanelQuery(searchPanel["queries"]); err != nil {
+		return TerraformObject{}, err
+	}
 	if description, ok := searchPanel["description"]; ok {
 		tfSearchPanel[0]["description"] = description
 	}
 	if timeRange := searchPanel["timeRange"]; timeRange != nil {
-		tfSearchPanel[0]["time_range"] = GetTerraformTimeRange(timeRange.(map[string]interface{}))
+		if tfSearchPanel[0]["time_range"], err = getTerraformDashboardTimeRange(timeRange, "timeRange"); err != nil {
+			return TerraformObject{}, err
+		}
 	}
 	if coloringRules := searchPanel["coloringRules"]; coloringRules != nil {
-		tfSearchPanel[0]["coloring_rule"] = GetTerraformTimeRange(coloringRules.(map[string]interface{}))
+		if tfSearchPanel[0]["coloring_rule"], err = getTerraformDashboardTimeRange(coloringRules, "coloringRules"); err != nil {
+			return TerraformObject{}, err
+		}
 	}
 	if linkedDashboards := searchPanel["linkedDashboards"]; linkedDashboards != nil {
-		tfSearchPanel[0]["linked_dashboard"] = getTerraformLinkedDashboards(linkedDashboards.([]interface{}))
+		if tfSearchPanel[0]["linked_dashboard"], err = getTerraformLinkedDashboards(linkedDashboards); err != nil {
+			return TerraformObject{}, err
+		}
 	}
 
-	return tfSearchPanel
+	return tfSearchPanel, nil
 }
 
-func getTerraformTracesListPanel(panel map[string]interface{}) TerraformObject {
+func getTerraformTracesListPanel(panel map[string]interface{}) (TerraformObject, error) {
 	tfTracesListPanel := MakeTerraformObject()
 
 	tfTracesListPanel[0]["key"] = panel["key"]
@@ -1253,13 +1843,18 @@ func getTerraformTracesListPanel(panel map[string]interface{}) TerraformObject {
 		tfTracesListPanel[0]["keep_visual_settings_consistent_with_parent"] = keepVisualSettingsConsistentWithParent
 	}
 
-	tfTracesListPanel[0]["queries"] = getTerraformSearchPanelQuery(panel["queries"].([]interface{}))
+	var err error
+	if tfTracesListPanel[0]["queries"], err = getTerraformSearchPanelQuery(panel["queries"]); err != nil {
+		return TerraformObject{}, err
+	}
 
 	if timeRange := panel["timeRange"]; timeRange != nil {
-		tfTracesListPanel[0]["time_range"] = GetTerraformTimeRange(timeRange.(map[string]interface{}))
+		if tfTracesListPanel[0]["time_range"], err = getTerraformDashboardTimeRange(timeRange, "timeRange"); err != nil {
+			return TerraformObject{}, err
+		}
 	}
 
-	return tfTracesListPanel
+	return tfTracesListPanel, nil
 }
 
 func getTerraformServiceMapPanel(panel map[string]interface{}) TerraformObject {
@@ -1292,11 +1887,14 @@ func getTerraformServiceMapPanel(panel map[string]interface{}) TerraformObject {
 	return tfServiceMapPanel
 }
 
-func getTerraformSearchPanelQuery(queries []interface{}) []map[string]interface{} {
+func getTerraformSearchPanelQuery(value interface{}) ([]map[string]interface{}, error) {
+	queries, err := dashboardJsonObjects(value, "queries")
+	if err != nil || queries == nil {
+		return nil, err
+	}
 	tfPanelQueries := make([]map[string]interface{}, len(queries))
 
-	for i, val := range queries {
-		query := val.(map[string]interface{})
+	for i, query := range queries {
 		tfPanelQueries[i] = make(map[string]interface{})
 		tfPanelQueries[i]["query_string"] = query["queryString"]
 		tfPanelQueries[i]["query_type"] = query["queryType"]
@@ -1305,8 +1903,9 @@ func getTerraformSearchPanelQuery(queries []interface{}) []map[string]interface{
 			tfPanelQueries[i]["metrics_query_mode"] = metricsQueryMode
 		}
 		if metricsQueryData, ok := query["metricsQueryData"]; ok && metricsQueryData != nil {
-			tfPanelQueries[i]["metrics_query_data"] =
-				getTerraformMetricsQueryDataScheme(metricsQueryData.(map[string]interface{}))
+			if tfPanelQueries[i]["metrics_query_data"], err = getTerraformMetricsQueryDataScheme(metricsQueryData); err != nil {
+				return nil, fmt.Errorf("invalid query %v: %v", query["queryKey"], err)
+			}
 		}
 		if parseMode, ok := query["parseMode"]; ok {
 			tfPanelQueries[i]["parse_mode"] = parseMode
@@ -1321,10 +1920,14 @@ func getTerraformSearchPanelQuery(queries []interface{}) []map[string]interface{
 			tfPanelQueries[i]["output_cardinality_limit"] = outputCardinalityLimit
 		}
 	}
-	return tfPanelQueries
+	return tfPanelQueries, nil
 }
 
-func getTerraformMetricsQueryDataScheme(queryData map[string]interface{}) TerraformObject {
+func getTerraformMetricsQueryDataScheme(value interface{}) (TerraformObject, error) {
+	queryData, err := dashboardJsonObject(value, "metricsQueryData")
+	if err != nil {
+		return TerraformObject{}, err
+	}
 	tfMetricsQueryData := MakeTerraformObject()
 
 	tfMetricsQueryData[0]["metric"] = queryData["metric"]
@@ -1335,10 +1938,12 @@ func getTerraformMetricsQueryDataScheme(queryData map[string]interface{}) Terraf
 		tfMetricsQueryData[0]["group_by"] = groupBy
 	}
 
-	filters := queryData["filters"].([]interface{})
+	filters, err := dashboardJsonObjects(queryData["filters"], "metricsQueryData.filters")
+	if err != nil {
+		return TerraformObject{}, err
+	}
 	tfFilters := make([]map[string]interface{}, len(filters))
-	for i, val := range filters {
-		filter := val.(map[string]interface{})
+	for i, filter := range filters {
 		tfFilters[i] = make(map[string]interface{})
 		tfFilters[i]["key"] = filter["key"]
 		tfFilters[i]["value"] = filter["value"]
@@ -1347,40 +1952,49 @@ func getTerraformMetricsQueryDataScheme(queryData map[string]interface{}) Terraf
 	tfMetricsQueryData[0]["filter"] = tfFilters
 
 	if val, ok := queryData["operators"]; ok && val != nil {
-		operators := val.([]interface{})
+		operators, err := dashboardJsonObjects(val, "metricsQueryData.operators")
+		if err != nil {
+			return TerraformObject{}, err
+		}
 		tfOperators := make([]map[string]interface{}, len(operators))
-		for i, val := range operators {
-			operator := val.(map[string]interface{})
-			tfOperators[i] = getTerraformMetricsQueryOperator(operator)
+		for i, operator := range operators {
+			if tfOperators[i], err = getTerraformMetricsQueryOperator(operator); err != nil {
+				return TerraformObject{}, err
+			}
 		}
 		tfMetricsQueryData[0]["operator"] = tfOperators
 	}
 
-	return tfMetricsQueryData
+	return tfMetricsQueryData, nil
 }
 
-func getTerraformMetricsQueryOperator(operator map[string]interface{}) map[string]interface{} {
+func getTerraformMetricsQueryOperator(operator map[string]interface{}) (map[string]interface{}, error) {
 	tfOperator := make(map[string]interface{})
 	tfOperator["operator_name"] = operator["operatorName"]
 
-	parameters := operator["parameters"].([]interface{})
+	parameters, err := dashboardJsonObjects(operator["parameters"], fmt.Sprintf("parameters of operator %v", operator["operatorName"]))
+	if err != nil {
+		return nil, err
+	}
 	tfParameters := make([]map[string]interface{}, len(parameters))
-	for i, val := range parameters {
-		parameter := val.(map[string]interface{})
+	for i, parameter := range parameters {
 		tfParameters[i] = make(map[string]interface{})
 		tfParameters[i]["key"] = parameter["key"]
 		tfParameters[i]["value"] = parameter["value"]
 	}
 	tfOperator["parameter"] = tfParameters
 
-	return tfOperator
+	return tfOperator, nil
 }
 
-func getTerraformLinkedDashboards(dashboards []interface{}) []map[string]interface{} {
+func getTerraformLinkedDashboards(value interface{}) ([]map[string]interface{}, error) {
+	dashboards, err := dashboardJsonObjects(value, "linkedDashboards")
+	if err != nil {
+		return nil, err
+	}
 	tfLinkedDashboards := make([]map[string]interface{}, len(dashboards))
 
-	for i, val := range dashboards {
-		dashboard := val.(map[string]interface{})
+	for i, dashboard := range dashboards {
 		tfLinkedDashboards[i] = make(map[string]interface{})
 		tfLinkedDashboards[i]["id"] = dashboard["id"]
 		tfLinkedDashboards[i]["relative_path"] = dashboard["relativePath"]
@@ -1388,32 +2002,36 @@ func getTerraformLinkedDashboards(dashboards []interface{}) []map[string]interfa
 		tfLinkedDashboards[i]["include_variables"] = dashboard["includeVariables"]
 	}
 
-	return tfLinkedDashboards
+	return tfLinkedDashboards, nil
 }
 
-func getTerraformLayout(layout map[string]interface{}) []map[string]interface{} {
+func getTerraformLayout(layout map[string]interface{}) ([]map[string]interface{}, error) {
 	tfLayout := []map[string]interface{}{}
 	tfLayout = append(tfLayout, make(map[string]interface{}))
 
 	if layout["layoutType"] == "Grid" {
 		gridLayout := MakeTerraformObject()
 
-		layoutStructures := layout["layoutStructures"].([]interface{})
-		tfLayoutStructures := make([]map[string]interface{}, len(layoutStructures))
-		for i, structure := range layoutStructures {
-			tfLayoutStructures[i] = structure.(map[string]interface{})
+		tfLayoutStructures, err := dashboardJsonObjects(layout["layoutStructures"], "layout.layoutStructures")
+		if err != nil {
+			return nil, err
 		}
 		gridLayout[0]["layout_structure"] = tfLayoutStructures
 		tfLayout[0]["grid"] = gridLayout
 	}
 
-	return tfLayout
+	return tfLayout, nil
 }
 
-func getTerraformVariables(variables []Variable) []map[string]interface{} {
+func getTerraformVariables(variables []Variable) ([]map[string]interface{}, error) {
 	tfVariables := make([]map[string]interface{}, len(variables))
 
 	for i, variable := range variables {
+		sourceDefinition, err := dashboardJsonObject(variable.SourceDefinition,
+			fmt.Sprintf("sourceDefinition of variable %s", variable.Name))
+		if err != nil {
+			return nil, err
+		}
 		tfVariables[i] = make(map[string]interface{})
 		tfVariables[i]["name"] = variable.Name
 		tfVariables[i]["display_name"] = variable.DisplayName
@@ -1421,11 +2039,10 @@ func getTerraformVariables(variables []Variable) []map[string]interface{} {
 		tfVariables[i]["allow_multi_select"] = variable.AllowMultiSelect
 		tfVariables[i]["include_all_option"] = variable.IncludeAllOption
 		tfVariables[i]["hide_from_ui"] = variable.HideFromUI
-		tfVariables[i]["source_definition"] =
-			getTerraformVariableSourceDefinition(variable.SourceDefinition.(map[string]interface{}))
+		tfVariables[i]["source_definition"] = getTerraformVariableSourceDefinition(sourceDefinition)
 	}
 
-	return tfVariables
+	return tfVariables, nil
 }
 
 func getTerraformVariableSourceDefinition(sourceDefinition map[string]interface{}) TerraformObject {
@@ -1445,6 +2062,11 @@ func getTerraformVariableSourceDefinition(sourceDefinition map[string]interface{
 		logQueryDefinition[0]["query"] = sourceDefinition["query"]
 		logQueryDefinition[0]["field"] = sourceDefinition["field"]
 		tfSourceDefinition[0]["log_query_variable_source_definition"] = logQueryDefinition
+	} else if sourceDefinition["variableSourceType"] == "LookupTableVariableSourceDefinition" {
+		lookupTableDefinition := MakeTerraformObject()
+		lookupTableDefinition[0]["lookup_table_id"] = sourceDefinition["lookupTableId"]
+		lookupTableDefinition[0]["field"] = sourceDefinition["field"]
+		tfSourceDefinition[0]["lookup_table_variable_source_definition"] = lookupTableDefinition
 	}
 
 	return tfSourceDefinition
@@ -1472,10 +2094,66 @@ func getTerraformColoringRules(coloringRules []ColoringRule) []map[string]interf
 	return tfColoringRules
 }
 
+func resourceSumologicDashboardCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	previousVariables, tfVariables := d.GetChange("variable")
+	if err := validateDashboardVariables(d.Get("panel").([]interface{}), tfVariables.([]interface{}),
+		previousVariables.([]interface{})); err != nil {
+		return err
+	}
+	// Only look up the referenced lookup tables when the variables change, rather than on every plan.
+	if c, ok := meta.(*Client); ok && d.HasChange("variable") {
+		if err := validateLookupTableVariables(c, tfVariables.([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	if tfAutoLayout, ok := getAutoLayoutConfig(d.Get("auto_layout").([]interface{})); ok {
+		if err := validateAutoLayout(getTerraformPanelKeys(d.Get("panel").([]interface{})), tfAutoLayout); err != nil {
+			return err
+		}
+	}
+
+	for i, val := range d.Get("panel").([]interface{}) {
+		tfPanel, ok := val.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("panel %d must contain exactly one of %s", i, strings.Join(getPanelBlockNames(), ", "))
+		}
+		var blocks []string
+		for block, tfBlock := range tfPanel {
+			if list, ok := tfBlock.([]interface{}); ok && len(list) > 0 {
+				blocks = append(blocks, block)
+			}
+		}
+		if len(blocks) != 1 {
+			return fmt.Errorf("panel %d must contain exactly one of %s", i, strings.Join(getPanelBlockNames(), ", "))
+		}
+		if metricsPanels := tfPanel["metrics_explorer_panel"].([]interface{}); len(metricsPanels) == 1 && metricsPanels[0] != nil {
+			tfMetricsPanel := metricsPanels[0].(map[string]interface{})
+			for _, tfQuery := range tfMetricsPanel["query"].([]interface{}) {
+				if queryType := tfQuery.(map[string]interface{})["query_type"].(string); queryType != "" && queryType != "Metrics" {
+					return fmt.Errorf("invalid panel %s: metrics_explorer_panel only supports queries of query_type Metrics",
+						tfMetricsPanel["key"])
+				}
+			}
+		}
+		if searchPanels := tfPanel["sumo_search_panel"].([]interface{}); len(searchPanels) == 1 && searchPanels[0] != nil {
+			tfSearchPanel := searchPanels[0].(map[string]interface{})
+			if err := validateVisualSettingsConfig(tfSearchPanel["visual_settings"].(string),
+				tfSearchPanel[visualSettingsConfigFieldName].([]interface{})); err != nil {
+				return fmt.Errorf("invalid panel %s: %s", tfSearchPanel["key"], err)
+			}
+		}
+	}
+	return nil
+}
+
 func resourceSumologicDashboardCreate(d *schema.ResourceData, meta interface{}) error {
 	c := meta.(*Client)
 	if d.Id() == "" {
-		dashboard := resourceToDashboard(d)
+		dashboard, err := resourceToDashboard(d)
+		if err != nil {
+			return err
+		}
 		log.Println("=====================================================================")
 		log.Printf("Creating dashboard: %+v\n", dashboard)
 		log.Println("=====================================================================")
@@ -1519,13 +2197,16 @@ func resourceSumologicDashboardDelete(d *schema.ResourceData, meta interface{})
 }
 
 func resourceSumologicDashboardUpdate(d *schema.ResourceData, meta interface{}) error {
-	dashboard := resourceToDashboard(d)
+	dashboard, err := resourceToDashboard(d)
+	if err != nil {
+		return err
+	}
 	log.Println("=====================================================================")
 	log.Printf("Updating dashboard: %+v\n", dashboard)
 	log.Println("=====================================================================")
 
 	c := meta.(*Client)
-	err := c.UpdateDashboard(dashboard)
+	err = c.UpdateDashboard(dashboard)
 
 	if err != nil {
 		return err
@@ -2,6 +2,7 @@ package sumologic
 
 import (
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
 	"testing"
@@ -20,6 +21,58 @@ var topologyLabel = TopologyLabel{
 	},
 }
 
+func TestGetTerraformPanels_panelTypes(t *testing.T) {
+	panelsJson := `[
+		{"key": "honeycomb-01", "title": "Hosts", "panelType": "HoneycombPanel", "description": "CPU of hosts",
+			"queries": [{"queryString": "metric=CPU_Total", "queryType": "Metrics", "queryKey": "A"}]},
+		{"key": "map-01", "panelType": "MapPanel",
+			"queries": [{"queryString": "_sourceCategory=api | lookup latitude, longitude from geo://location on ip=src_ip",
+				"queryType": "Logs", "queryKey": "A"}]},
+		{"key": "metrics-explorer-01", "panelType": "MetricsExplorerPanel",
+			"queries": [{"queryString": "metric=Latency", "queryType": "Metrics", "queryKey": "A"}]},
+		{"key": "links-01", "title": "Runbooks", "panelType": "LinksPanel",
+			"links": [{"title": "Api runbook", "url": "https://example.com/runbooks/api", "description": "On call"}]}
+	]`
+	definition, err := expandDashboardJson(`{"panels": ` + panelsJson + `}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedPanelTypes := []string{"HoneycombPanel", "MapPanel", "MetricsExplorerPanel"}
+	for i, panelType := range expectedPanelTypes {
+		panel, ok := definition.Panels[i].(QueryPanel)
+		if !ok || panel.PanelType != panelType || len(panel.Queries) != 1 {
+			t.Errorf("expected %s with one query, got %+v", panelType, definition.Panels[i])
+		}
+	}
+	if panel := definition.Panels[0].(QueryPanel); panel.Title != "Hosts" || panel.Description != "CPU of hosts" {
+		t.Errorf("unexpected honeycomb panel %+v", panel)
+	}
+	linksPanel, ok := definition.Panels[3].(LinksPanel)
+	expectedLinks := []PanelLink{{Title: "Api runbook", Url: "https://example.com/runbooks/api", Description: "On call"}}
+	if !ok || !reflect.DeepEqual(linksPanel.Links, expectedLinks) {
+		t.Errorf("unexpected links panel %+v", definition.Panels[3])
+	}
+}
+
+func TestGetTerraformPanels_unsupportedPanelType(t *testing.T) {
+	panels := []interface{}{
+		map[string]interface{}{"key": "alerts-01", "panelType": "AlertsListPanel"},
+	}
+	if _, err := getTerraformPanels(panels, nil); err == nil ||
+		!strings.Contains(err.Error(), `panel alerts-01 has unsupported panel type "AlertsListPanel"`) {
+		t.Errorf("expected unsupported panel type error, got %v", err)
+	}
+
+	tfPanel := map[string]interface{}{}
+	for block := range getPanelSchema() {
+		tfPanel[block] = []interface{}{}
+	}
+	if _, err := getPanel(tfPanel); err == nil || !strings.Contains(err.Error(), "must contain exactly one of") {
+		t.Errorf("expected error for a panel without a panel block, got %v", err)
+	}
+}
+
 func TestAccSumologicDashboard_basic(t *testing.T) {
 	testNameSuffix := acctest.RandString(16)
 	title := "terraform_test_dashboard_" + testNameSuffix
@@ -1,18 +1,20 @@
 package sumologic
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceSumologicFolder() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceSumologicFolderCreate,
-		Read:   resourceSumologicFolderRead,
-		Delete: resourceSumologicFolderDelete,
-		Update: resourceSumologicFolderUpdate,
+		CreateContext: resourceSumologicFolderCreate,
+		ReadContext:   resourceSumologicFolderRead,
+		DeleteContext: resourceSumologicFolderDelete,
+		UpdateContext: resourceSumologicFolderUpdate,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -38,7 +40,7 @@ func resourceSumologicFolder() *schema.Resource {
 	}
 }
 
-func resourceSumologicFolderRead(d *schema.ResourceData, meta interface{}) error {
+func resourceSumologicFolderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*Client)
 	// Retrieve the folder Id from the state
 	id := d.Id()
@@ -46,7 +48,7 @@ func resourceSumologicFolderRead(d *schema.ResourceData, meta interface{}) error
 
 	folder, err := c.GetFolder(id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Ensure the Folder is populated
@@ -65,13 +67,13 @@ func resourceSumologicFolderRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceSumologicFolderDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceSumologicFolderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*Client)
 	log.Printf("[DEBUG] Deleting folder: %s", d.Id())
-	return c.DeleteFolder(d.Id(), d.Timeout(schema.TimeoutDelete))
+	return diag.FromErr(c.DeleteFolder(ctx, d.Id(), d.Timeout(schema.TimeoutDelete)))
 }
 
-func resourceSumologicFolderCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceSumologicFolderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*Client)
 
 	// If there is no id in the state, then we need to create the object
@@ -81,23 +83,23 @@ func resourceSumologicFolderCreate(d *schema.ResourceData, meta interface{}) err
 
 		id, err := c.CreateFolder(folder)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		d.SetId(id)
 	}
 
-	return resourceSumologicFolderRead(d, meta)
+	return resourceSumologicFolderRead(ctx, d, meta)
 }
 
-func resourceSumologicFolderUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceSumologicFolderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*Client)
 
 	// Load all data from the schema into a Folder Struct
 	folder := resourceToFolder(d)
 
 	// Update the folder and return any errors
-	return c.UpdateFolder(folder)
+	return diag.FromErr(c.UpdateFolder(folder))
 }
 
 func resourceToFolder(d *schema.ResourceData) Folder {
@@ -101,7 +101,8 @@ func resourceSumologicMonitorsLibraryFolder() *schema.Resource {
 				},
 			},
 
-			"obj_permission": GetCmfFgpObjPermSetSchema(),
+			"obj_permission":                    GetCmfFgpObjPermSetSchema(),
+			"obj_permission_managed_externally": GetCmfFgpObjPermManagedExternallySchema(),
 		},
 	}
 }
@@ -159,17 +160,21 @@ func resourceSumologicMonitorsLibraryFolderRead(d *schema.ResourceData, meta int
 		return nil
 	}
 
-	fgpResponse, fgpGetErr := c.GetCmfFgp(fgpTargetType, folder.ID)
-	if fgpGetErr != nil {
-		// if FGP endpoint is not enabled (not implemented), we should suppress this error
-		suppressedErrorCode := HasErrorCode(fgpGetErr.Error(), []string{"not_implemented_yet", "api_not_enabled"})
-		if suppressedErrorCode == "" {
-			return fgpGetErr
+	if CmfFgpObjPermManagedExternally(d) {
+		d.Set("obj_permission", nil)
+	} else {
+		fgpResponse, fgpGetErr := c.GetCmfFgp(fgpTargetType, folder.ID)
+		if fgpGetErr != nil {
+			// if FGP endpoint is not enabled (not implemented), we should suppress this error
+			suppressedErrorCode := HasErrorCode(fgpGetErr.Error(), []string{"not_implemented_yet", "api_not_enabled"})
+			if suppressedErrorCode == "" {
+				return fgpGetErr
+			} else {
+				log.Printf("[WARN] FGP Feature has not been enabled yet. Suppressing \"%s\" error under GetCmfFgp operation.", suppressedErrorCode)
+			}
 		} else {
-			log.Printf("[WARN] FGP Feature has not been enabled yet. Suppressing \"%s\" error under GetCmfFgp operation.", suppressedErrorCode)
+			CmfFgpPermStmtsSetToResource(d, fgpResponse.PermissionStatements)
 		}
-	} else {
-		CmfFgpPermStmtsSetToResource(d, fgpResponse.PermissionStatements)
 	}
 
 	d.Set("created_by", folder.CreatedBy)
@@ -198,33 +203,36 @@ func resourceSumologicMonitorsLibraryFolderUpdate(d *schema.ResourceData, meta i
 		return err
 	}
 
-	// converting Reource FGP to Struct
-	permStmts, convErr := ResourceToCmfFgpPermStmts(d, monitorFolder.ID)
-	if convErr != nil {
-		return convErr
-	}
+	// Permissions that are managed externally, e.g. by a sumologic_cmf_permissions resource, are left untouched.
+	if !CmfFgpObjPermManagedExternally(d) {
+		// converting Reource FGP to Struct
+		permStmts, convErr := ResourceToCmfFgpPermStmts(d, monitorFolder.ID)
+		if convErr != nil {
+			return convErr
+		}
 
-	// reading FGP from Backend to reconcile
-	fgpGetResponse, fgpGetErr := c.GetCmfFgp(fgpTargetType, monitorFolder.ID)
-	if fgpGetErr != nil {
-		// if FGP endpoint is not enabled (not implemented) and FGP feature is not used,
-		// we should suppress this error
-		suppressedErrorCode := HasErrorCode(fgpGetErr.Error(), []string{"not_implemented_yet", "api_not_enabled"})
-		if suppressedErrorCode == "" && len(permStmts) == 0 {
-			return fgpGetErr
-		} else {
-			log.Printf("[WARN] FGP Feature has not been enabled yet. Suppressing \"%s\" error under GetCmfFgp operation.", suppressedErrorCode)
+		// reading FGP from Backend to reconcile
+		fgpGetResponse, fgpGetErr := c.GetCmfFgp(fgpTargetType, monitorFolder.ID)
+		if fgpGetErr != nil {
+			// if FGP endpoint is not enabled (not implemented) and FGP feature is not used,
+			// we should suppress this error
+			suppressedErrorCode := HasErrorCode(fgpGetErr.Error(), []string{"not_implemented_yet", "api_not_enabled"})
+			if suppressedErrorCode == "" && len(permStmts) == 0 {
+				return fgpGetErr
+			} else {
+				log.Printf("[WARN] FGP Feature has not been enabled yet. Suppressing \"%s\" error under GetCmfFgp operation.", suppressedErrorCode)
+			}
 		}
-	}
 
-	if len(permStmts) > 0 || fgpGetResponse != nil {
-		_, fgpSetErr := c.SetCmfFgp(fgpTargetType, CmfFgpRequest{
-			PermissionStatements: ReconcileFgpPermStmtsWithEmptyPerms(
-				permStmts, fgpGetResponse.PermissionStatements,
-			),
-		})
-		if fgpSetErr != nil {
-			return fgpSetErr
+		if len(permStmts) > 0 || fgpGetResponse != nil {
+			_, fgpSetErr := c.SetCmfFgp(fgpTargetType, CmfFgpRequest{
+				PermissionStatements: ReconcileFgpPermStmtsWithEmptyPerms(
+					permStmts, fgpGetResponse.PermissionStatements,
+				),
+			})
+			if fgpSetErr != nil {
+				return fgpSetErr
+			}
 		}
 	}
 
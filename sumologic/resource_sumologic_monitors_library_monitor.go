@@ -1,6 +1,7 @@
 package sumologic
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -19,6 +20,7 @@ func resourceSumologicMonitorsLibraryMonitor() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceSumologicMonitorsLibraryMonitorCustomizeDiff,
 
 		Schema: getMonitorSchema(),
 	}
@@ -53,7 +55,7 @@ func getMonitorBaseSchema() map[string]*schema.Schema {
 		"monitor_type": {
 			Type:         schema.TypeString,
 			Required:     true,
-			ValidateFunc: validation.StringInSlice([]string{"Logs", "Metrics", "Slo"}, false),
+			ValidateFunc: validation.StringInSlice([]string{"Logs", "Metrics", "Slo", "Composite"}, false),
 		},
 
 		"evaluation_delay": {
@@ -180,6 +182,14 @@ func getMonitorBaseSchema() map[string]*schema.Schema {
 							Schema: metricsAnomalyTriggerConditionSchema,
 						},
 					},
+					compositeConditionFieldName: {
+						Type:     schema.TypeList,
+						MaxItems: 1,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: compositeTriggerConditionSchema,
+						},
+					},
 				},
 			},
 		},
@@ -380,7 +390,8 @@ func getMonitorSchema() map[string]*schema.Schema {
 			},
 		},
 
-		"obj_permission": GetCmfFgpObjPermSetSchema(),
+		"obj_permission":                    GetCmfFgpObjPermSetSchema(),
+		"obj_permission_managed_externally": GetCmfFgpObjPermManagedExternallySchema(),
 
 		"is_system": {
 			Type:     schema.TypeBool,
@@ -452,6 +463,7 @@ var (
 		"trigger_conditions.0.slo_burn_rate_condition",
 		fmt.Sprintf("trigger_conditions.0.%s", logsAnomalyConditionFieldName),
 		fmt.Sprintf("trigger_conditions.0.%s", metricsAnomalyConditionFieldName),
+		fmt.Sprintf("trigger_conditions.0.%s", compositeConditionFieldName),
 	}
 	logStaticConditionCriticalOrWarningAtleastOneKeys = []string{
 		"trigger_conditions.0.logs_static_condition.0.warning",
@@ -477,6 +489,10 @@ var (
 		"trigger_conditions.0.slo_burn_rate_condition.0.warning",
 		"trigger_conditions.0.slo_burn_rate_condition.0.critical",
 	}
+	compositeConditionCriticalOrWarningAtleastOneKeys = []string{
+		"trigger_conditions.0.composite_condition.0.warning",
+		"trigger_conditions.0.composite_condition.0.critical",
+	}
 )
 
 // Trigger Condition schemas
@@ -688,6 +704,41 @@ var metricsAnomalyTriggerConditionSchema = map[string]*schema.Schema{
 	}),
 }
 
+var compositeTriggerConditionSchema = map[string]*schema.Schema{
+	"monitor_ids": {
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	},
+	"operator": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringInSlice([]string{"AND", "OR"}, false),
+	},
+	"critical": nestedWithAtleastOneOfKeys(true, schemaMap{
+		"time_range":     &timeRangeWithAllowedValuesSchema,
+		"monitor_states": &monitorStatesSchema,
+	}, compositeConditionCriticalOrWarningAtleastOneKeys),
+	"warning": nestedWithAtleastOneOfKeys(true, schemaMap{
+		"time_range":     &timeRangeWithAllowedValuesSchema,
+		"monitor_states": &monitorStatesSchema,
+	}, compositeConditionCriticalOrWarningAtleastOneKeys),
+}
+
+var monitorStatesSchema = schema.Schema{
+	Type:     schema.TypeList,
+	Optional: true,
+	Computed: true,
+	Elem: &schema.Schema{
+		Type:         schema.TypeString,
+		ValidateFunc: validation.StringInSlice([]string{"Critical", "Warning", "MissingData"}, false),
+	},
+}
+
 func getBurnRateSchema(triggerType string) *schema.Schema {
 	burnRateThresholdConflict := fmt.Sprintf("trigger_conditions.0.slo_burn_rate_condition.0.%s.0.burn_rate_threshold", triggerType)
 	timeRangeConflict := fmt.Sprintf("trigger_conditions.0.slo_burn_rate_condition.0.%s.0.time_range", triggerType)
@@ -871,16 +922,20 @@ func resourceSumologicMonitorsLibraryMonitorRead(d *schema.ResourceData, meta in
 		return nil
 	}
 
-	fgpResponse, fgpErr := c.GetCmfFgp(fgpTargetType, monitor.ID)
-	if fgpErr != nil {
-		suppressedErrorCode := HasErrorCode(fgpErr.Error(), []string{"not_implemented_yet", "api_not_enabled"})
-		if suppressedErrorCode == "" {
-			return fgpErr
+	if CmfFgpObjPermManagedExternally(d) {
+		d.Set("obj_permission", nil)
+	} else {
+		fgpResponse, fgpErr := c.GetCmfFgp(fgpTargetType, monitor.ID)
+		if fgpErr != nil {
+			suppressedErrorCode := HasErrorCode(fgpErr.Error(), []string{"not_implemented_yet", "api_not_enabled"})
+			if suppressedErrorCode == "" {
+				return fgpErr
+			} else {
+				log.Printf("[WARN] FGP Feature has not been enabled yet. Suppressing \"%s\" error under GetCmfFgp operation.", suppressedErrorCode)
+			}
 		} else {
-			log.Printf("[WARN] FGP Feature has not been enabled yet. Suppressing \"%s\" error under GetCmfFgp operation.", suppressedErrorCode)
+			CmfFgpPermStmtsSetToResource(d, fgpResponse.PermissionStatements)
 		}
-	} else {
-		CmfFgpPermStmtsSetToResource(d, fgpResponse.PermissionStatements)
 	}
 
 	// Always use "Normal" as status; otherwise it can cause state to drift from backend.
@@ -1030,39 +1085,42 @@ func resourceSumologicMonitorsLibraryMonitorUpdate(d *schema.ResourceData, meta
 		return err
 	}
 
-	// converting Resource FGP to Struct
-	permStmts, convErr := ResourceToCmfFgpPermStmts(d, monitor.ID)
-	if convErr != nil {
-		return convErr
-	}
-
-	// reading FGP from Backend to reconcile
-	fgpGetResponse, fgpGetErr := c.GetCmfFgp(fgpTargetType, monitor.ID)
-	if fgpGetErr != nil {
-		/*
-		   |errCode         |  len  | logic                   |
-		   |--------------------------------------------------|
-		   |server_error    |   0   | return err at Get       |
-		   |server_error    |   1   | warn; return err at Set |
-		   |not_enabled     |   0   | warn                    |
-		   |not_enabled     |   1   | warn; return err at Set |
-		*/
-		suppressedErrorCode := HasErrorCode(fgpGetErr.Error(), []string{"not_implemented_yet", "api_not_enabled"})
-		if suppressedErrorCode == "" && len(permStmts) == 0 {
-			return fgpGetErr
-		} else {
-			log.Printf("[WARN] FGP Feature has not been enabled yet. Suppressing \"%s\" error under GetCmfFgp operation.", suppressedErrorCode)
+	// Permissions that are managed externally, e.g. by a sumologic_cmf_permissions resource, are left untouched.
+	if !CmfFgpObjPermManagedExternally(d) {
+		// converting Resource FGP to Struct
+		permStmts, convErr := ResourceToCmfFgpPermStmts(d, monitor.ID)
+		if convErr != nil {
+			return convErr
 		}
-	}
 
-	if len(permStmts) > 0 || fgpGetResponse != nil {
-		_, fgpSetErr := c.SetCmfFgp(fgpTargetType, CmfFgpRequest{
-			PermissionStatements: ReconcileFgpPermStmtsWithEmptyPerms(
-				permStmts, fgpGetResponse.PermissionStatements,
-			),
-		})
-		if fgpSetErr != nil {
-			return fgpSetErr
+		// reading FGP from Backend to reconcile
+		fgpGetResponse, fgpGetErr := c.GetCmfFgp(fgpTargetType, monitor.ID)
+		if fgpGetErr != nil {
+			/*
+			   |errCode         |  len  | logic                   |
+			   |--------------------------------------------------|
+			   |server_error    |   0   | return err at Get       |
+			   |server_error    |   1   | warn; return err at Set |
+			   |not_enabled     |   0   | warn                    |
+			   |not_enabled     |   1   | warn; return err at Set |
+			*/
+			suppressedErrorCode := HasErrorCode(fgpGetErr.Error(), []string{"not_implemented_yet", "api_not_enabled"})
+			if suppressedErrorCode == "" && len(permStmts) == 0 {
+				return fgpGetErr
+			} else {
+				log.Printf("[WARN] FGP Feature has not been enabled yet. Suppressing \"%s\" error under GetCmfFgp operation.", suppressedErrorCode)
+			}
+		}
+
+		if len(permStmts) > 0 || fgpGetResponse != nil {
+			_, fgpSetErr := c.SetCmfFgp(fgpTargetType, CmfFgpRequest{
+				PermissionStatements: ReconcileFgpPermStmtsWithEmptyPerms(
+					permStmts, fgpGetResponse.PermissionStatements,
+				),
+			})
+			if fgpSetErr != nil {
+				return fgpSetErr
+			}
 		}
 	}
 
@@ -1081,6 +1139,97 @@ func resourceSumologicMonitorsLibraryMonitorDelete(d *schema.ResourceData, meta
 	return nil
 }
 
+func resourceSumologicMonitorsLibraryMonitorCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	compositeCondition, isComposite := fromSingletonArray(triggerConditionsFromResourceDiff(d), compositeConditionFieldName)
+	monitorType := d.Get("monitor_type").(string)
+	if isComposite != (monitorType == "Composite") && d.NewValueKnown("monitor_type") {
+		return fmt.Errorf("trigger_conditions.0.%s must be used with, and only with, monitor_type Composite", compositeConditionFieldName)
+	}
+	if !isComposite {
+		return nil
+	}
+	if len(d.Get("queries").([]interface{})) > 0 {
+		return fmt.Errorf("composite monitors must not have queries, they are evaluated on the states of monitor_ids")
+	}
+
+	// Monitor ids of monitors created in the same apply are only known once those exist, they are
+	// validated in the diff computed during the apply.
+	if !d.NewValueKnown(fmt.Sprintf("trigger_conditions.0.%s.0.monitor_ids", compositeConditionFieldName)) {
+		return nil
+	}
+	var monitorIds []string
+	for _, id := range compositeCondition["monitor_ids"].([]interface{}) {
+		if id, ok := id.(string); ok && id != "" {
+			monitorIds = append(monitorIds, id)
+		}
+	}
+	return validateCompositeMonitorReferences(meta.(*Client), d.Id(), monitorIds)
+}
+
+func triggerConditionsFromResourceDiff(d *schema.ResourceDiff) map[string]interface{} {
+	if arr, ok := d.Get("trigger_conditions").([]interface{}); ok && len(arr) == 1 {
+		if block, ok := arr[0].(map[string]interface{}); ok {
+			return block
+		}
+	}
+	return map[string]interface{}{}
+}
+
+// validateCompositeMonitorReferences checks that the monitors referenced by a composite monitor exist, and that
+// following references of composite monitors never leads back to the monitor itself.
+func validateCompositeMonitorReferences(c *Client, monitorID string, referencedIds []string) error {
+	visited := map[string]bool{}
+	var visit func(id string, path []string) error
+	visit = func(id string, path []string) error {
+		path = append(path, id)
+		if monitorID != "" && id == monitorID {
+			return fmt.Errorf("composite monitor references form a cycle: %s", strings.Join(path, " -> "))
+		}
+		if visited[id] {
+			return nil
+		}
+		visited[id] = true
+
+		monitor, err := c.MonitorsRead(id)
+		if err != nil {
+			return err
+		}
+		isDirectReference := len(path) == 2
+		if monitor == nil || monitor.Type == "MonitorsLibraryFolder" {
+			if isDirectReference {
+				return fmt.Errorf("monitor with id %s referenced by %s does not exist", id, compositeConditionFieldName)
+			}
+			// dangling references of other composite monitors are not ours to report
+			return nil
+		}
+
+		for _, trigger := range monitor.Triggers {
+			if trigger.DetectionMethod != compositeConditionDetectionMethod {
+				continue
+			}
+			for _, ref := range trigger.MonitorIds {
+				if err := visit(ref, path); err != nil {
+					return err
+				}
+			}
+			// all triggers of a composite monitor reference the same monitors
+			break
+		}
+		return nil
+	}
+
+	self := monitorID
+	if self == "" {
+		self = "(new monitor)"
+	}
+	for _, id := range referencedIds {
+		if err := visit(id, []string{self}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func getNotifications(d *schema.ResourceData) []MonitorNotification {
 	rawNotifications := d.Get("notifications").([]interface{})
 	notifications := make([]MonitorNotification, len(rawNotifications))
@@ -1191,6 +1340,9 @@ func triggerConditionsBlockToJson(block map[string]interface{}) []TriggerConditi
 	if sc, ok := fromSingletonArray(block, metricsAnomalyConditionFieldName); ok {
 		conditions = append(conditions, metricsAnomalyConditionBlockToJson(sc)...)
 	}
+	if sc, ok := fromSingletonArray(block, compositeConditionFieldName); ok {
+		conditions = append(conditions, compositeConditionBlockToJson(sc)...)
+	}
 
 	return conditions
 }
@@ -1332,6 +1484,27 @@ func metricsAnomalyConditionBlockToJson(block map[string]interface{}) []TriggerC
 	return base.cloneReadingFromNestedBlocks(block)
 }
 
+func compositeConditionBlockToJson(block map[string]interface{}) []TriggerCondition {
+	base := TriggerCondition{
+		Operator:        block["operator"].(string),
+		MonitorIds:      resourceToStringArray(block["monitor_ids"].([]interface{})),
+		DetectionMethod: compositeConditionDetectionMethod,
+	}
+	// composite condition does not have 'alert' and 'resolution' objects, the resolution triggers when the
+	// combination of monitor states stops holding. Here we generate empty blocks for reading to work
+	for _, triggerType := range []string{"critical", "warning"} {
+		if subBlock, ok := fromSingletonArray(block, triggerType); ok {
+			subBlock["alert"] = toSingletonArray(map[string]interface{}{})
+			subBlock["resolution"] = toSingletonArray(map[string]interface{}{})
+		}
+	}
+	conditions := base.cloneReadingFromNestedBlocks(block)
+	for i := range conditions {
+		conditions[i].computeMonitorStates(block)
+	}
+	return conditions
+}
+
 // TriggerCondition JSON model to 'trigger_conditions' block
 func jsonToTriggerConditionsBlock(conditions []TriggerCondition) map[string]interface{} {
 	missingDataConditions := make([]TriggerCondition, 0)
@@ -1362,6 +1535,8 @@ func jsonToTriggerConditionsBlock(conditions []TriggerCondition) map[string]inte
 			triggerConditionsBlock[logsAnomalyConditionFieldName] = toSingletonArray(jsonToLogsAnomalyConditionBlock(dataConditions))
 		case metricsAnomalyConditionDetectionMethod:
 			triggerConditionsBlock[metricsAnomalyConditionFieldName] = toSingletonArray(jsonToMetricsAnomalyConditionBlock(dataConditions))
+		case compositeConditionDetectionMethod:
+			triggerConditionsBlock[compositeConditionFieldName] = toSingletonArray(jsonToCompositeConditionBlock(dataConditions))
 
 		}
 	}
@@ -1683,6 +1858,37 @@ func jsonToMetricsAnomalyConditionBlock(conditions []TriggerCondition) map[strin
 	return block
 }
 
+func jsonToCompositeConditionBlock(conditions []TriggerCondition) map[string]interface{} {
+	var criticalDict, warningDict = dict{}, dict{}
+	block := map[string]interface{}{}
+
+	block["operator"] = conditions[0].Operator
+	block["monitor_ids"] = convertStringsToInterfaces(conditions[0].MonitorIds)
+	block["critical"] = toSingletonArray(criticalDict)
+	block["warning"] = toSingletonArray(warningDict)
+
+	var hasCritical, hasWarning = false, false
+	for _, condition := range conditions {
+		switch condition.TriggerType {
+		case "Critical":
+			hasCritical = true
+			criticalDict["time_range"] = condition.PositiveTimeRange()
+			criticalDict["monitor_states"] = convertStringsToInterfaces(condition.MonitorStates)
+		case "Warning":
+			hasWarning = true
+			warningDict["time_range"] = condition.PositiveTimeRange()
+			warningDict["monitor_states"] = convertStringsToInterfaces(condition.MonitorStates)
+		}
+	}
+	if !hasCritical {
+		delete(block, "critical")
+	}
+	if !hasWarning {
+		delete(block, "warning")
+	}
+	return block
+}
+
 func getAlertBlock(condition TriggerCondition) dict {
 	var alert = dict{}
 	burnRates := make([]interface{}, len(condition.BurnRates))
@@ -1727,6 +1933,7 @@ const sloSLIConditionFieldName = "slo_sli_condition"
 const sloBurnRateConditionFieldName = "slo_burn_rate_condition"
 const logsAnomalyConditionFieldName = "logs_anomaly_condition"
 const metricsAnomalyConditionFieldName = "metrics_anomaly_condition"
+const compositeConditionFieldName = "composite_condition"
 
 const logsStaticConditionDetectionMethod = "LogsStaticCondition"
 const metricsStaticConditionDetectionMethod = "MetricsStaticCondition"
@@ -1738,6 +1945,7 @@ const sloSLIConditionDetectionMethod = "SloSliCondition"
 const sloBurnRateConditionDetectionMethod = "SloBurnRateCondition"
 const logsAnomalyConditionDetectionMethod = "LogsAnomalyCondition"
 const metricsAnomalyConditionDetectionMethod = "MetricsAnomalyCondition"
+const compositeConditionDetectionMethod = "CompositeCondition"
 
 func getQueries(d *schema.ResourceData) []MonitorQuery {
 	rawQueries := d.Get("queries").([]interface{})
@@ -2003,6 +2211,18 @@ func (condition *TriggerCondition) computeBurnRates(block map[string]interface{}
 	}
 }
 
+// Reads the monitor states of the critical or warning block matching the condition, defaulting to the state
+// of the same severity.
+func (condition *TriggerCondition) computeMonitorStates(block map[string]interface{}) {
+	severity := strings.TrimPrefix(condition.TriggerType, "Resolved")
+	condition.MonitorStates = []string{severity}
+	if subBlock, ok := fromSingletonArray(block, strings.ToLower(severity)); ok {
+		if states, ok := subBlock["monitor_states"].([]interface{}); ok && len(states) > 0 {
+			condition.MonitorStates = resourceToStringArray(states)
+		}
+	}
+}
+
 func toSingletonArray(m map[string]interface{}) []map[string]interface{} {
 	return []map[string]interface{}{m}
 }
@@ -2,7 +2,9 @@ package sumologic
 
 import (
 	"fmt"
+	"io"
 	"log"
+	"net/http"
 	"regexp"
 	"sort"
 	"strconv"
@@ -84,6 +86,12 @@ func TestSumologicMonitorsLibraryMonitor_conversionsToFromTriggerConditionsShoul
 			exampleSloBurnRateTriggerConditionWithoutBurnRates("Critical"),
 			exampleSloBurnRateTriggerConditionWithoutBurnRates("Warning"),
 		},
+		{
+			exampleCompositeTriggerCondition("Critical", []string{"Critical"}),
+			exampleCompositeTriggerCondition("ResolvedCritical", []string{"Critical"}),
+			exampleCompositeTriggerCondition("Warning", []string{"Critical", "Warning"}),
+			exampleCompositeTriggerCondition("ResolvedWarning", []string{"Critical", "Warning"}),
+		},
 	}
 	for _, triggerConditions := range testTriggerConditions {
 		triggerConditionsAfterRoundTrip := triggerConditionsBlockToJson(jsonToTriggerConditionsBlock(triggerConditions))
@@ -107,6 +115,58 @@ func TestSumologicMonitorsLibraryMonitor_conversionsToFromTriggerConditionsShoul
 	}
 }
 
+type mockMonitorsHttpClient struct {
+	monitors map[string]string
+}
+
+func (c *mockMonitorsHttpClient) Do(req *http.Request) (*http.Response, error) {
+	id := strings.TrimPrefix(req.URL.Path, "/api/v1/monitors/")
+	if body, ok := c.monitors[id]; ok {
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body))}, nil
+	}
+	return &http.Response{StatusCode: 404, Body: io.NopCloser(strings.NewReader(`{"errors":[]}`))}, nil
+}
+
+func TestSumologicMonitorsLibraryMonitor_validateCompositeMonitorReferences(t *testing.T) {
+	compositeMonitor := func(monitorIds ...string) string {
+		return fmt.Sprintf(`{"type": "MonitorsLibraryMonitor", "triggers": [
+			{"triggerType": "Critical", "detectionMethod": "CompositeCondition", "operator": "AND", "monitorIds": ["%s"]}
+		]}`, strings.Join(monitorIds, `","`))
+	}
+	client := newTestClient(nil)
+	client.httpClient = &mockMonitorsHttpClient{monitors: map[string]string{
+		"latency":   `{"type": "MonitorsLibraryMonitor", "triggers": [{"triggerType": "Critical", "detectionMethod": "LogsStaticCondition"}]}`,
+		"errors":    `{"type": "MonitorsLibraryMonitor", "triggers": [{"triggerType": "Critical", "detectionMethod": "LogsStaticCondition"}]}`,
+		"folder":    `{"type": "MonitorsLibraryFolder"}`,
+		"paging":    compositeMonitor("latency", "errors"),
+		"escalate":  compositeMonitor("paging", "self"),
+		"dangling":  compositeMonitor("latency", "deleted"),
+		"diamond-1": compositeMonitor("paging"),
+		"diamond-2": compositeMonitor("paging", "diamond-1"),
+	}}
+
+	testCases := []struct {
+		monitorID     string
+		referencedIds []string
+		expectedError string
+	}{
+		{"", []string{"latency", "errors"}, ""},
+		{"self", []string{"paging", "dangling", "diamond-2"}, ""},
+		{"", []string{"latency", "missing"}, "monitor with id missing referenced by composite_condition does not exist"},
+		{"", []string{"folder"}, "monitor with id folder referenced by composite_condition does not exist"},
+		{"self", []string{"self"}, "cycle: self -> self"},
+		{"self", []string{"latency", "escalate"}, "cycle: self -> escalate -> self"},
+	}
+	for _, tc := range testCases {
+		err := validateCompositeMonitorReferences(client, tc.monitorID, tc.referencedIds)
+		if tc.expectedError == "" && err != nil {
+			t.Errorf("%v: unexpected error: %v", tc.referencedIds, err)
+		} else if tc.expectedError != "" && (err == nil || !strings.Contains(err.Error(), tc.expectedError)) {
+			t.Errorf("%v: expected error containing %q, got %v", tc.referencedIds, tc.expectedError, err)
+		}
+	}
+}
+
 func TestAccSumologicMonitorsLibraryMonitor_schemaTriggerValidations(t *testing.T) {
 	config := `
        resource "sumologic_monitor" "test" {
@@ -711,6 +771,87 @@ func TestAccSumologicMonitorsLibraryMonitor_override_payload(t *testing.T) {
 	})
 }
 
+func TestAccSumologicMonitorsLibraryMonitor_composite(t *testing.T) {
+	testNameSuffix := acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMonitorsLibraryMonitorDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSumologicMonitorsLibraryMonitorComposite(testNameSuffix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sumologic_monitor.composite", "monitor_type", "Composite"),
+					resource.TestCheckResourceAttr("sumologic_monitor.composite",
+						"trigger_conditions.0.composite_condition.0.operator", "AND"),
+					resource.TestCheckResourceAttr("sumologic_monitor.composite",
+						"trigger_conditions.0.composite_condition.0.monitor_ids.#", "2"),
+					resource.TestCheckResourceAttr("sumologic_monitor.composite",
+						"trigger_conditions.0.composite_condition.0.critical.0.monitor_states.0", "Critical"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSumologicMonitorsLibraryMonitorComposite(testNameSuffix string) string {
+	logsMonitor := func(name string, query string) string {
+		return fmt.Sprintf(`
+resource "sumologic_monitor" "%s" {
+	name         = "terraform_test_composite_%s_%s"
+	monitor_type = "Logs"
+	queries {
+		row_id = "A"
+		query  = "%s"
+	}
+	trigger_conditions {
+		logs_static_condition {
+			critical {
+				time_range = "15m"
+				alert {
+					threshold      = 40.0
+					threshold_type = "GreaterThan"
+				}
+				resolution {
+					threshold      = 40.0
+					threshold_type = "LessThanOrEqual"
+				}
+			}
+		}
+	}
+}
+`, name, name, testNameSuffix, query)
+	}
+
+	return logsMonitor("latency", "_sourceCategory=monitor-manager latency") +
+		logsMonitor("errors", "_sourceCategory=monitor-manager error") + fmt.Sprintf(`
+resource "sumologic_monitor" "composite" {
+	name         = "terraform_test_composite_%s"
+	monitor_type = "Composite"
+	trigger_conditions {
+		composite_condition {
+			monitor_ids = [sumologic_monitor.latency.id, sumologic_monitor.errors.id]
+			operator    = "AND"
+			critical {
+				time_range = "15m"
+			}
+		}
+	}
+	notifications {
+		notification {
+			connection_type = "Email"
+			recipients      = ["abc@example.com"]
+			subject         = "latency and errors"
+			time_zone       = "PST"
+			message_body    = "test"
+		}
+		run_for_trigger_types = ["Critical", "ResolvedCritical"]
+	}
+}
+`, testNameSuffix)
+}
+
 func testAccCheckMonitorsLibraryMonitorFolderMatch(monitorName string, folderName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		//fetching monitor information
@@ -1711,6 +1852,17 @@ func exampleSloSliTriggerCondition(triggerType string, threshold float64) Trigge
 	}
 }
 
+func exampleCompositeTriggerCondition(triggerType string, monitorStates []string) TriggerCondition {
+	return TriggerCondition{
+		TimeRange:       "15m",
+		TriggerType:     triggerType,
+		Operator:        "AND",
+		MonitorIds:      []string{"0000000000000001", "0000000000000002"},
+		MonitorStates:   monitorStates,
+		DetectionMethod: "CompositeCondition",
+	}
+}
+
 func exampleSloBurnRateTriggerConditionWithOnlyBurnRates(triggerType string) TriggerCondition {
 	return TriggerCondition{
 		TriggerType:     triggerType,
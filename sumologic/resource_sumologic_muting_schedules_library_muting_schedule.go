@@ -1,6 +1,7 @@
 package sumologic
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -17,8 +18,13 @@ func resourceSumologicMutingSchedulesLibraryMutingSchedule() *schema.Resource {
 		Update: resourceSumologicMutingSchedulesLibraryMutingScheduleUpdate,
 		Delete: resourceSumologicMutingSchedulesLibraryMutingScheduleDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// Defaults are not applied on import, set it so that the import is not followed by an update.
+				d.Set("next_occurrences_count", 5)
+				return []*schema.ResourceData{d}, nil
+			},
 		},
+		CustomizeDiff: resourceSumologicMutingScheduleCustomizeDiff,
 
 		Schema: getMutingScheduleSchema(),
 	}
@@ -153,8 +159,9 @@ func getScheduleDefinitionSchemma() map[string]*schema.Schema {
 			ValidateFunc: validation.IntAtLeast(15),
 		},
 		"rrule": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateRecurrenceRule,
 		},
 	}
 }
@@ -212,6 +219,30 @@ func getMutingScheduleSchema() map[string]*schema.Schema {
 			Optional: true,
 			Computed: true,
 		},
+
+		"next_occurrences_count": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      5,
+			ValidateFunc: validation.IntBetween(0, 100),
+		},
+
+		"next_occurrences": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"start": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"end": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
 	}
 
 	for k, v := range additionalAttributes {
@@ -289,6 +320,12 @@ func resourceSumologicMutingSchedulesLibraryMutingScheduleRead(d *schema.Resourc
 	d.Set("schedule", schedule)
 	d.Set("notification_groups", notificationGroupArrayToResource(mutingSchedule.NotificationGroups))
 
+	occurrences, err := getMutingScheduleOccurrences(mutingSchedule.Schedule, time.Now(), d.Get("next_occurrences_count").(int))
+	if err != nil {
+		log.Printf("[WARN] Unable to preview the next occurrences of MutingSchedule %s: %v", d.Id(), err)
+	}
+	d.Set("next_occurrences", occurrences)
+
 	return nil
 }
 
@@ -315,6 +352,83 @@ func resourceSumologicMutingSchedulesLibraryMutingScheduleDelete(d *schema.Resou
 	return nil
 }
 
+// resourceSumologicMutingScheduleCustomizeDiff validates the schedule as a whole. The preview of the mute
+// windows is refreshed by Read, so it is only marked as changing when the schedule changes and the
+// passage of time alone does not produce a diff.
+func resourceSumologicMutingScheduleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	changed := d.Id() == "" || d.HasChange("schedule") || d.HasChange("next_occurrences_count")
+	for _, field := range []string{"schedule.0.timezone", "schedule.0.start_date", "schedule.0.start_time", "schedule.0.duration", "schedule.0.rrule"} {
+		if !d.NewValueKnown(field) {
+			return d.SetNewComputed("next_occurrences")
+		}
+	}
+
+	schedules := d.Get("schedule").([]interface{})
+	if len(schedules) == 0 || schedules[0] == nil {
+		return nil
+	}
+	scheduleDict := schedules[0].(map[string]interface{})
+	schedule := ScheduleDefinition{
+		TimeZone:  scheduleDict["timezone"].(string),
+		StartDate: scheduleDict["start_date"].(string),
+		StartTime: scheduleDict["start_time"].(string),
+		Duration:  scheduleDict["duration"].(int),
+		RRule:     scheduleDict["rrule"].(string),
+	}
+
+	if _, _, _, err := validateScheduleRecurrence(schedule); err != nil {
+		return err
+	}
+
+	if changed {
+		return d.SetNewComputed("next_occurrences")
+	}
+	return nil
+}
+
+// getMutingScheduleOccurrences returns up to limit mute windows of the schedule that have not ended by now.
+func getMutingScheduleOccurrences(schedule ScheduleDefinition, now time.Time, limit int) ([]interface{}, error) {
+	rule, location, start, err := validateScheduleRecurrence(schedule)
+	if err != nil {
+		return nil, err
+	}
+	duration := time.Duration(schedule.Duration) * time.Minute
+
+	var starts []time.Time
+	if rule == nil {
+		starts = []time.Time{start}
+	} else {
+		starts = expandRecurrenceRule(rule, start, now.In(location).Add(-duration+time.Nanosecond), limit)
+	}
+
+	occurrences := make([]interface{}, 0, len(starts))
+	for _, occurrenceStart := range starts {
+		occurrenceEnd := occurrenceStart.Add(duration)
+		if !occurrenceEnd.After(now) || len(occurrences) == limit {
+			continue
+		}
+		occurrences = append(occurrences, map[string]interface{}{
+			"start": occurrenceStart.Format(time.RFC3339),
+			"end":   occurrenceEnd.Format(time.RFC3339),
+		})
+	}
+	return occurrences, nil
+}
+
+func validateRecurrenceRule(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return warnings, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+	if v == "" {
+		return warnings, errors
+	}
+	if _, err := parseRecurrenceRule(v); err != nil {
+		return warnings, []error{fmt.Errorf("expected %q to be a valid RFC 5545 recurrence rule: %v", k, err)}
+	}
+	return warnings, errors
+}
+
 func getMonitorScope(d *schema.ResourceData) *MonitorScope {
 	monitorMap := d.Get("monitor").([]interface{})
 	if len(monitorMap) == 0 {
@@ -13,6 +13,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func TestSumologicMutingSchedule_readNextOccurrences(t *testing.T) {
+	client, _ := newRoutingTestClient(map[string]string{
+		"v1/mutingSchedules/0000000000000001": `{"id": "0000000000000001", "name": "nightly",
+			"monitor": {"all": true}, "schedule": {"timezone": "UTC", "startDate": "2099-01-01", "startTime": "01:00", "duration": 30, "rrule": "FREQ=DAILY"}}`,
+	})
+	d := resourceSumologicMutingSchedulesLibraryMutingSchedule().Data(&terraform.InstanceState{
+		ID:         "0000000000000001",
+		Attributes: map[string]string{"next_occurrences_count": "2"},
+	})
+
+	if err := resourceSumologicMutingSchedulesLibraryMutingScheduleRead(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if start := d.Get("next_occurrences.1.start"); d.Get("next_occurrences.#") != 2 || start != "2099-01-02T01:00:00Z" {
+		t.Errorf("unexpected next occurrences %v", d.Get("next_occurrences"))
+	}
+}
+
 func TestAccSumologicMutingSchedulesLibraryMutingSchedule_basic(t *testing.T) {
 	var mutingSchedulesLibraryMutingSchedule MutingSchedulesLibraryMutingSchedule
 	testNameSuffix := acctest.RandString(16)
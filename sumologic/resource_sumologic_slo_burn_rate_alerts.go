@@ -0,0 +1,368 @@
+package sumologic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const fastBurnFieldName = "fast_burn"
+const slowBurnFieldName = "slow_burn"
+
+// Standard multi-window, multi-burn-rate alerting thresholds, i.e. 2% and 5% of the
+// error budget spent within 1h and 6h (fast burn), 10% spent within 1d and 3d (slow burn).
+var defaultSLOBurnRates = map[string][]BurnRate{
+	fastBurnFieldName: {
+		{BurnRateThreshold: 14.4, TimeRange: "1h"},
+		{BurnRateThreshold: 6, TimeRange: "6h"},
+	},
+	slowBurnFieldName: {
+		{BurnRateThreshold: 3, TimeRange: "1d"},
+		{BurnRateThreshold: 1, TimeRange: "3d"},
+	},
+}
+
+var defaultSLOBurnRateTriggerTypes = map[string]string{
+	fastBurnFieldName: "Critical",
+	slowBurnFieldName: "Warning",
+}
+
+func resourceSumologicSLOBurnRateAlerts() *schema.Resource {
+	burnRateTierSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"trigger_type": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"Critical", "Warning"}, false),
+					},
+					"burn_rate": {
+						Type:     schema.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"burn_rate_threshold": {
+									Type:         schema.TypeFloat,
+									Required:     true,
+									ValidateFunc: validation.FloatAtLeast(0),
+								},
+								"time_range": &timeRangeWithFormatSchema,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		Create: resourceSumologicSLOBurnRateAlertsCreate,
+		Read:   resourceSumologicSLOBurnRateAlertsRead,
+		Update: resourceSumologicSLOBurnRateAlertsUpdate,
+		Delete: resourceSumologicSLOBurnRateAlertsDelete,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if d.Id() == "" {
+				return nil
+			}
+			// Regenerate the monitors whenever the SLO they alert on has changed its compliance
+			// objective, or when one of the generated monitors has been deleted outside of Terraform.
+			c := meta.(*Client)
+			slo, err := c.SLORead(d.Get("slo_id").(string))
+			if err != nil {
+				return err
+			}
+			if slo == nil {
+				return nil
+			}
+			if slo.Compliance.Target != d.Get("compliance_target").(float64) {
+				if err := d.SetNew("compliance_target", slo.Compliance.Target); err != nil {
+					return err
+				}
+			}
+			if size := sloComplianceSize(slo.Compliance); size != d.Get("compliance_size").(string) {
+				if err := d.SetNew("compliance_size", size); err != nil {
+					return err
+				}
+			}
+			for _, field := range []string{"fast_burn_monitor_id", "slow_burn_monitor_id"} {
+				if d.Get(field).(string) == "" {
+					if err := d.SetNewComputed(field); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"slo_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"parent_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.All(
+					validation.StringDoesNotContainAny("/"),
+					validation.StringIsNotWhiteSpace,
+				),
+			},
+			fastBurnFieldName: burnRateTierSchema(),
+			slowBurnFieldName: burnRateTierSchema(),
+			"notifications":   getMonitorSchema()["notifications"],
+			"is_disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"fast_burn_monitor_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"slow_burn_monitor_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compliance_target": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"compliance_size": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSumologicSLOBurnRateAlertsCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	slo, err := c.SLORead(d.Get("slo_id").(string))
+	if err != nil {
+		return err
+	}
+	if slo == nil {
+		return fmt.Errorf("SLO with id %s not found", d.Get("slo_id").(string))
+	}
+
+	if d.Get("name_prefix").(string) == "" {
+		d.Set("name_prefix", slo.Name)
+	}
+	if d.Get("parent_id").(string) == "" {
+		rootFolder, err := c.GetMonitorsLibraryFolder("root")
+		if err != nil {
+			return err
+		}
+		d.Set("parent_id", rootFolder.ID)
+	}
+
+	for _, tier := range []string{fastBurnFieldName, slowBurnFieldName} {
+		monitor := resourceToSLOBurnRateMonitor(d, slo, tier)
+		monitorID, err := c.CreateMonitorsLibraryMonitor(monitor, map[string]string{
+			"parentId": monitor.ParentID,
+		})
+		if err != nil {
+			return err
+		}
+		d.Set(tier+"_monitor_id", monitorID)
+		// Record the SLO as soon as the first monitor exists so that a failure on the second
+		// one does not orphan the monitor that was already created.
+		d.SetId(slo.ID)
+	}
+	setSLOBurnRateAlertsCompliance(d, slo)
+
+	return resourceSumologicSLOBurnRateAlertsRead(d, meta)
+}
+
+func resourceSumologicSLOBurnRateAlertsRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	slo, err := c.SLORead(d.Get("slo_id").(string))
+	if err != nil {
+		return err
+	}
+	if slo == nil {
+		log.Printf("[WARN] SLO not found, removing burn rate alerts from state: %v", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	for _, tier := range []string{fastBurnFieldName, slowBurnFieldName} {
+		field := tier + "_monitor_id"
+		monitorID := d.Get(field).(string)
+		if monitorID == "" {
+			continue
+		}
+		monitor, err := c.MonitorsRead(monitorID)
+		if err != nil {
+			return err
+		}
+		if monitor == nil {
+			log.Printf("[WARN] Burn rate monitor %s for SLO %s not found, it will be recreated", monitorID, slo.ID)
+			d.Set(field, "")
+			continue
+		}
+		if tier == fastBurnFieldName {
+			d.Set("parent_id", monitor.ParentID)
+			d.Set("is_disabled", monitor.IsDisabled)
+		}
+	}
+
+	return nil
+}
+
+func resourceSumologicSLOBurnRateAlertsUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	slo, err := c.SLORead(d.Get("slo_id").(string))
+	if err != nil {
+		return err
+	}
+	if slo == nil {
+		return fmt.Errorf("SLO with id %s not found", d.Get("slo_id").(string))
+	}
+
+	for _, tier := range []string{fastBurnFieldName, slowBurnFieldName} {
+		field := tier + "_monitor_id"
+		monitor := resourceToSLOBurnRateMonitor(d, slo, tier)
+
+		if monitor.ID == "" {
+			monitorID, err := c.CreateMonitorsLibraryMonitor(monitor, map[string]string{
+				"parentId": monitor.ParentID,
+			})
+			if err != nil {
+				return err
+			}
+			d.Set(field, monitorID)
+			continue
+		}
+
+		if d.HasChange("parent_id") {
+			movedMonitor, err := c.MoveMonitorsLibraryMonitor(monitor.ID, monitor.ParentID)
+			if err != nil {
+				return err
+			}
+			monitor.Version = movedMonitor.Version
+		} else if existingMonitor, err := c.MonitorsRead(monitor.ID); err != nil {
+			return err
+		} else if existingMonitor != nil {
+			monitor.Version = existingMonitor.Version
+		}
+
+		monitor.Type = "MonitorsLibraryMonitorUpdate"
+		if err := c.UpdateMonitorsLibraryMonitor(monitor); err != nil {
+			return err
+		}
+	}
+	setSLOBurnRateAlertsCompliance(d, slo)
+
+	return resourceSumologicSLOBurnRateAlertsRead(d, meta)
+}
+
+func resourceSumologicSLOBurnRateAlertsDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	for _, field := range []string{"fast_burn_monitor_id", "slow_burn_monitor_id"} {
+		if monitorID := d.Get(field).(string); monitorID != "" {
+			if err := c.DeleteMonitorsLibraryMonitor(monitorID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceToSLOBurnRateMonitor(d *schema.ResourceData, slo *SLOLibrarySLO, tier string) MonitorsLibraryMonitor {
+	triggerType, burnRates := getSLOBurnRateTier(d, tier)
+
+	burnRateBlocks := make([]interface{}, len(burnRates))
+	for i, burnRate := range burnRates {
+		burnRateBlocks[i] = map[string]interface{}{
+			"burn_rate_threshold": burnRate.BurnRateThreshold,
+			"time_range":          burnRate.TimeRange,
+		}
+	}
+	triggers := sloBurnConditionBlockToJson(map[string]interface{}{
+		strings.ToLower(triggerType): []interface{}{
+			map[string]interface{}{"burn_rate": burnRateBlocks},
+		},
+	})
+
+	tierName := "Fast"
+	if tier == slowBurnFieldName {
+		tierName = "Slow"
+	}
+
+	return MonitorsLibraryMonitor{
+		ID:                 d.Get(tier + "_monitor_id").(string),
+		Name:               fmt.Sprintf("%s %s Burn", d.Get("name_prefix").(string), tierName),
+		Description:        fmt.Sprintf("%s burn rate alert for SLO %s (target %v%% over %s)", tierName, slo.Name, slo.Compliance.Target, sloComplianceSize(slo.Compliance)),
+		Type:               "MonitorsLibraryMonitor",
+		ContentType:        "Monitor",
+		MonitorType:        "Slo",
+		SloID:              slo.ID,
+		ParentID:           d.Get("parent_id").(string),
+		Triggers:           triggers,
+		Notifications:      getNotifications(d),
+		IsDisabled:         d.Get("is_disabled").(bool),
+		GroupNotifications: true,
+		Status:             []string{"Normal"},
+		Tags:               d.Get("tags").(map[string]interface{}),
+	}
+}
+
+// setSLOBurnRateAlertsCompliance records the compliance objective the monitors were generated
+// from. Read leaves it alone so that a change of the SLO shows up as a diff.
+func setSLOBurnRateAlertsCompliance(d *schema.ResourceData, slo *SLOLibrarySLO) {
+	d.Set("compliance_target", slo.Compliance.Target)
+	d.Set("compliance_size", sloComplianceSize(slo.Compliance))
+}
+
+func getSLOBurnRateTier(d *schema.ResourceData, tier string) (string, []BurnRate) {
+	triggerType := defaultSLOBurnRateTriggerTypes[tier]
+	burnRates := defaultSLOBurnRates[tier]
+
+	if block, ok := singletonFromResourceData(d, tier); ok {
+		if t, ok := block["trigger_type"].(string); ok && t != "" {
+			triggerType = t
+		}
+		condition := TriggerCondition{}
+		condition.computeBurnRates(block)
+		if len(condition.BurnRates) > 0 {
+			burnRates = condition.BurnRates
+		}
+	}
+
+	return triggerType, burnRates
+}
+
+func sloComplianceSize(compliance SLOCompliance) string {
+	if compliance.ComplianceType == "Calendar" {
+		return compliance.WindowType
+	}
+	return compliance.Size
+}
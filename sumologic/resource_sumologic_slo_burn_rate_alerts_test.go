@@ -0,0 +1,269 @@
+package sumologic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestSumologicSLOBurnRateAlerts_defaultMonitors(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceSumologicSLOBurnRateAlerts().Schema, map[string]interface{}{
+		"slo_id":      "0000000000000001",
+		"name_prefix": "Login",
+		"parent_id":   "0000000000000002",
+	})
+	slo := &SLOLibrarySLO{
+		ID:   "0000000000000001",
+		Name: "login availability",
+		Compliance: SLOCompliance{
+			ComplianceType: "Rolling",
+			Size:           "30d",
+			Target:         99.9,
+		},
+	}
+
+	fast := resourceToSLOBurnRateMonitor(d, slo, fastBurnFieldName)
+	if fast.Name != "Login Fast Burn" || fast.SloID != slo.ID || fast.MonitorType != "Slo" {
+		t.Errorf("unexpected fast burn monitor: %+v", fast)
+	}
+	expectedFast := []TriggerCondition{{
+		TriggerType:     "Critical",
+		DetectionMethod: sloBurnRateConditionDetectionMethod,
+		BurnRates:       defaultSLOBurnRates[fastBurnFieldName],
+	}}
+	if diff := cmp.Diff(expectedFast, fast.Triggers); diff != "" {
+		t.Errorf("unexpected fast burn triggers (-want +got):\n%s", diff)
+	}
+
+	slow := resourceToSLOBurnRateMonitor(d, slo, slowBurnFieldName)
+	expectedSlow := []TriggerCondition{{
+		TriggerType:     "Warning",
+		DetectionMethod: sloBurnRateConditionDetectionMethod,
+		BurnRates:       defaultSLOBurnRates[slowBurnFieldName],
+	}}
+	if diff := cmp.Diff(expectedSlow, slow.Triggers); diff != "" {
+		t.Errorf("unexpected slow burn triggers (-want +got):\n%s", diff)
+	}
+	if slow.Description != "Slow burn rate alert for SLO login availability (target 99.9% over 30d)" {
+		t.Errorf("unexpected slow burn description: %s", slow.Description)
+	}
+}
+
+func TestSumologicSLOBurnRateAlerts_overriddenTier(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceSumologicSLOBurnRateAlerts().Schema, map[string]interface{}{
+		"slo_id": "0000000000000001",
+		"fast_burn": []interface{}{
+			map[string]interface{}{
+				"trigger_type": "Warning",
+				"burn_rate": []interface{}{
+					map[string]interface{}{"burn_rate_threshold": 10.0, "time_range": "30m"},
+				},
+			},
+		},
+	})
+
+	triggerType, burnRates := getSLOBurnRateTier(d, fastBurnFieldName)
+	if triggerType != "Warning" {
+		t.Errorf("expected trigger type Warning, got %s", triggerType)
+	}
+	if diff := cmp.Diff([]BurnRate{{BurnRateThreshold: 10, TimeRange: "30m"}}, burnRates); diff != "" {
+		t.Errorf("unexpected burn rates (-want +got):\n%s", diff)
+	}
+}
+
+func exampleSLOBurnRateAlertsClient(target float64) (*Client, *mockRoutingHttpClient) {
+	return newRoutingTestClient(map[string]string{
+		"v1/slos/0000000000000001": fmt.Sprintf(`{"id": "0000000000000001", "name": "login availability",
+			"compliance": {"complianceType": "Rolling", "size": "30d", "target": %v}}`, target),
+		"v1/monitors/0000000000000003": `{"id": "0000000000000003", "version": 2, "parentId": "0000000000000002"}`,
+		"v1/monitors/0000000000000004": `{"id": "0000000000000004", "version": 5, "parentId": "0000000000000002"}`,
+	})
+}
+
+func exampleSLOBurnRateAlertsState() *terraform.InstanceState {
+	return &terraform.InstanceState{
+		ID: "0000000000000001",
+		Attributes: map[string]string{
+			"slo_id":               "0000000000000001",
+			"parent_id":            "0000000000000002",
+			"name_prefix":          "Login",
+			"fast_burn_monitor_id": "0000000000000003",
+			"slow_burn_monitor_id": "0000000000000004",
+			"compliance_target":    "95",
+			"compliance_size":      "30d",
+		},
+	}
+}
+
+func TestSumologicSLOBurnRateAlerts_sloTargetChanged(t *testing.T) {
+	client, _ := exampleSLOBurnRateAlertsClient(99)
+	r := resourceSumologicSLOBurnRateAlerts()
+	state := exampleSLOBurnRateAlertsState()
+
+	// Refreshing must keep the target the monitors were generated from
+	d := r.Data(state)
+	if err := r.Read(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if target := d.Get("compliance_target"); target != 95.0 {
+		t.Errorf("expected the compliance target to stay 95, got %v", target)
+	}
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"slo_id":      "0000000000000001",
+		"parent_id":   "0000000000000002",
+		"name_prefix": "Login",
+	}), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff == nil || diff.Attributes["compliance_target"] == nil || diff.Attributes["compliance_target"].New != "99" {
+		t.Fatalf("expected a diff of the compliance target to 99, got %v", diff)
+	}
+}
+
+func TestSumologicSLOBurnRateAlerts_updateRegeneratesMonitors(t *testing.T) {
+	client, httpClient := exampleSLOBurnRateAlertsClient(99)
+	d := resourceSumologicSLOBurnRateAlerts().Data(exampleSLOBurnRateAlertsState())
+
+	if err := resourceSumologicSLOBurnRateAlertsUpdate(d, client); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range []string{"0000000000000003", "0000000000000004"} {
+		var monitor MonitorsLibraryMonitor
+		if err := json.Unmarshal([]byte(httpClient.requestBodies["PUT v1/monitors/"+id]), &monitor); err != nil {
+			t.Fatalf("expected monitor %s to be updated: %v", id, err)
+		}
+		if !strings.Contains(monitor.Description, "target 99% over 30d") {
+			t.Errorf("expected monitor %s to be generated for the new target, got %q", id, monitor.Description)
+		}
+	}
+	if target := d.Get("compliance_target"); target != 99.0 {
+		t.Errorf("expected the compliance target 99, got %v", target)
+	}
+}
+
+func TestAccSumologicSLOBurnRateAlerts_create(t *testing.T) {
+	testName := "terraform_test_slo_" + acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSLOBurnRateAlertsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSumologicSLOBurnRateAlertsConfig(testName, 95),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSLOBurnRateAlertsMonitor("sumologic_slo_burn_rate_alerts.test", "fast_burn_monitor_id", testName+" Fast Burn"),
+					testAccCheckSLOBurnRateAlertsMonitor("sumologic_slo_burn_rate_alerts.test", "slow_burn_monitor_id", testName+" Slow Burn"),
+					resource.TestCheckResourceAttr("sumologic_slo_burn_rate_alerts.test", "compliance_target", "95"),
+				),
+			},
+			{
+				Config: testAccSumologicSLOBurnRateAlertsConfig(testName, 99),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sumologic_slo_burn_rate_alerts.test", "compliance_target", "99"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSLOBurnRateAlertsDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Client)
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "sumologic_slo_burn_rate_alerts" {
+			continue
+		}
+		for _, field := range []string{"fast_burn_monitor_id", "slow_burn_monitor_id"} {
+			monitor, err := client.MonitorsRead(r.Primary.Attributes[field])
+			if err != nil {
+				return fmt.Errorf("Encountered an error: %w", err)
+			}
+			if monitor != nil {
+				return fmt.Errorf("Burn rate monitor %s still exists", monitor.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckSLOBurnRateAlertsMonitor(name, field, expectedName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("SLO burn rate alerts not found: %s", name)
+		}
+		client := testAccProvider.Meta().(*Client)
+		monitor, err := client.MonitorsRead(rs.Primary.Attributes[field])
+		if err != nil {
+			return err
+		}
+		if monitor == nil {
+			return fmt.Errorf("monitor %s not found", rs.Primary.Attributes[field])
+		}
+		if monitor.Name != expectedName {
+			return fmt.Errorf("expected monitor name %s, got %s", expectedName, monitor.Name)
+		}
+		if monitor.SloID != rs.Primary.Attributes["slo_id"] {
+			return fmt.Errorf("expected monitor to reference SLO %s, got %s", rs.Primary.Attributes["slo_id"], monitor.SloID)
+		}
+		return nil
+	}
+}
+
+func testAccSumologicSLOBurnRateAlertsConfig(testName string, target int) string {
+	return fmt.Sprintf(`
+resource "sumologic_slo" "test" {
+  name        = "%s"
+  description = "per minute login error rate over rolling 7 days"
+  signal_type = "Error"
+  compliance {
+    compliance_type = "Rolling"
+    size            = "7d"
+    target          = %d
+    timezone        = "Asia/Kolkata"
+  }
+  indicator {
+    request_based_evaluation {
+      query_type = "Logs"
+      queries {
+        query_group_type = "Unsuccessful"
+        query_group {
+          row_id        = "A"
+          query         = "_sourceCategory=login error"
+          use_row_count = true
+        }
+      }
+      queries {
+        query_group_type = "Total"
+        query_group {
+          row_id        = "A"
+          query         = "_sourceCategory=login"
+          use_row_count = true
+        }
+      }
+    }
+  }
+}
+
+resource "sumologic_slo_burn_rate_alerts" "test" {
+  slo_id = sumologic_slo.test.id
+
+  slow_burn {
+    burn_rate {
+      burn_rate_threshold = 3
+      time_range          = "1d"
+    }
+  }
+}
+`, testName, target)
+}
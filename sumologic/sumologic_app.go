@@ -1,6 +1,7 @@
 package sumologic
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -26,37 +27,57 @@ func (s *Client) GetAppInstance(id string) (*AppInstance, error) {
 	return &appInstance, nil
 }
 
-func (s *Client) CreateAppInstance(uuid string, appInstallPayload AppInstallPayload) (string, error) {
-	url := fmt.Sprintf("v2/apps/%s/install", uuid)
+// StartAppInstall starts a job that installs the app with the given uuid.
+func (s *Client) StartAppInstall(uuid string, appInstallPayload AppInstallPayload) (AsyncJob, error) {
+	return s.startAppJob("install", uuid, appInstallPayload)
+}
+
+// StartAppUpgrade starts a job that upgrades the installed app with the given uuid.
+func (s *Client) StartAppUpgrade(uuid string, appInstallPayload AppInstallPayload) (AsyncJob, error) {
+	return s.startAppJob("upgrade", uuid, appInstallPayload)
+}
+
+func (s *Client) startAppJob(action string, uuid string, appInstallPayload AppInstallPayload) (AsyncJob, error) {
+	url := fmt.Sprintf("v2/apps/%s/%s", uuid, action)
 	response, err := s.Post(url, appInstallPayload)
 	if err != nil {
-		return "", err
+		return AsyncJob{}, err
 	}
 
 	var jobId AppInstallJobId
 	err = json.Unmarshal(response, &jobId)
 	if err != nil {
-		return "", err
+		return AsyncJob{}, err
 	}
+	log.Printf("[DEBUG] App %s job id: %s", action, jobId.JOBID)
+
+	return AsyncJob{
+		ID:        jobId.JOBID,
+		StatusURL: fmt.Sprintf("v2/apps/%s/%s/status", action, jobId.JOBID),
+	}, nil
+}
 
-	// Wait for install job to finish
-	url = fmt.Sprintf("v2/apps/install/%s/status", jobId.JOBID)
-	_, err = waitForJob(url, time.Minute, s)
+// WaitForAppInstall waits for an app install or upgrade job and returns the id of the app instance.
+func (s *Client) WaitForAppInstall(ctx context.Context, job AsyncJob, timeout time.Duration) (string, error) {
+	_, err := s.WaitForAsyncJob(ctx, job, timeout)
 	if err != nil {
 		return "", err
 	}
 
 	var appInstallResponse AppInstallResponse
-	b, _ := s.Get(url)
+	b, err := s.Get(job.StatusURL)
+	if err != nil {
+		return "", err
+	}
 	err = json.Unmarshal(b, &appInstallResponse)
 	if err != nil {
 		return "", err
 	}
-	log.Printf("[CreateAppInstance] response: %+v\n", appInstallResponse)
+	log.Printf("[WaitForAppInstall] response: %+v\n", appInstallResponse)
 	return appInstallResponse.INSTANCEID, nil
 }
 
-func (s *Client) DeleteAppInstance(uuid string) error {
+func (s *Client) DeleteAppInstance(ctx context.Context, uuid string, timeout time.Duration) error {
 	url := fmt.Sprintf("v2/apps/%s/uninstall", uuid)
 	response, err := s.Post(url, nil)
 	if err != nil {
@@ -69,42 +90,12 @@ func (s *Client) DeleteAppInstance(uuid string) error {
 		return err
 	}
 
-	// Wait for install job to finish
+	// Wait for uninstall job to finish
 	url = fmt.Sprintf("v2/apps/uninstall/%s/status", jobId.JOBID)
-	_, err = waitForJob(url, time.Minute, s)
+	_, err = s.WaitForAsyncJob(ctx, AsyncJob{ID: jobId.JOBID, StatusURL: url}, timeout)
 	return err
 }
 
-func (s *Client) UpdateAppInstance(uuid string, appInstallPayload AppInstallPayload) (string, error) {
-	url := fmt.Sprintf("v2/apps/%s/upgrade", uuid)
-	response, err := s.Post(url, appInstallPayload)
-	if err != nil {
-		return "", err
-	}
-
-	var jobId AppInstallJobId
-	err = json.Unmarshal(response, &jobId)
-	if err != nil {
-		return "", err
-	}
-
-	// Wait for install job to finish
-	url = fmt.Sprintf("v2/apps/upgrade/%s/status", jobId.JOBID)
-	_, err = waitForJob(url, time.Minute, s)
-	if err != nil {
-		return "", err
-	}
-
-	var appInstallResponse AppInstallResponse
-	b, _ := s.Get(url)
-	err = json.Unmarshal(b, &appInstallResponse)
-	if err != nil {
-		return "", err
-	}
-	log.Printf("[UpdateAppInstance] response: %+v\n", appInstallResponse)
-	return appInstallResponse.INSTANCEID, nil
-}
-
 type AppInstallPayload struct {
 	VERSION    string                 `json:"version"`
 	PARAMETERS map[string]interface{} `json:"parameters"`
@@ -0,0 +1,234 @@
+package sumologic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	pendingJobFieldName = "pending_job"
+
+	// The delay before the status of a job is first requested, which doubles after every request that
+	// finds the job in progress, up to asyncJobMaxDelay.
+	asyncJobInitialDelay = 1 * time.Second
+	asyncJobMaxDelay     = 30 * time.Second
+)
+
+// AsyncJob is a job that runs on the server side, such as a content import or an app install, whose
+// status is polled until it completes.
+type AsyncJob struct {
+	ID        string
+	StatusURL string
+	// Operation is the operation of the resource that started the job, create or update, if any.
+	Operation string
+}
+
+// AsyncJobPendingError is returned when waiting for a job stops while the job is still in progress,
+// because the timeout expired or the context was cancelled.
+type AsyncJobPendingError struct {
+	Job     AsyncJob
+	Elapsed time.Duration
+	Err     error
+}
+
+func (e *AsyncJobPendingError) Error() string {
+	return fmt.Sprintf("async job %s is still in progress after %s: %s", e.Job.ID, e.Elapsed.Round(time.Second), e.Err)
+}
+
+func (e *AsyncJobPendingError) Unwrap() error {
+	return e.Err
+}
+
+// WaitForAsyncJob polls the status of the job, with an exponential backoff, until the job succeeds or
+// fails. It returns an AsyncJobPendingError if the job is still in progress when the timeout expires or
+// ctx is cancelled.
+func (s *Client) WaitForAsyncJob(ctx context.Context, job AsyncJob, timeout time.Duration) (*Status, error) {
+	start := time.Now()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	delay := asyncJobInitialDelay
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, &AsyncJobPendingError{Job: job, Elapsed: time.Since(start), Err: ctx.Err()}
+		case <-timer.C:
+			return nil, &AsyncJobPendingError{Job: job, Elapsed: time.Since(start),
+				Err: fmt.Errorf("timeout while waiting for the job to complete")}
+		case <-time.After(delay):
+		}
+
+		var status Status
+		b, err := s.Get(job.StatusURL)
+		if err != nil {
+			return nil, err
+		}
+		if b == nil {
+			return nil, fmt.Errorf("async job %s does not exist", job.ID)
+		}
+		err = json.Unmarshal(b, &status)
+		if err != nil {
+			return nil, err
+		}
+
+		switch status.Status {
+		case "Success":
+			log.Printf("[DEBUG] Async job %s succeeded after %s", job.ID, time.Since(start).Round(time.Second))
+			return &status, nil
+		case "Failed":
+			return &status, fmt.Errorf("async job failed - %s", status.Error)
+		}
+
+		log.Printf("[INFO] Async job %s is %s after %s: %s", job.ID, status.Status,
+			time.Since(start).Round(time.Second), status.StatusMessage)
+		delay *= 2
+		if delay > asyncJobMaxDelay {
+			delay = asyncJobMaxDelay
+		}
+	}
+}
+
+// asyncJobWaitFunc waits for a job started by a resource and returns the id of the resource.
+type asyncJobWaitFunc func(ctx context.Context, job AsyncJob, timeout time.Duration) (string, error)
+
+func getPendingJobSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"status_url": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"operation": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func setPendingJob(d *schema.ResourceData, job *AsyncJob) {
+	if job == nil {
+		d.Set(pendingJobFieldName, []interface{}{})
+		return
+	}
+	d.Set(pendingJobFieldName, []interface{}{
+		map[string]interface{}{
+			"id":         job.ID,
+			"status_url": job.StatusURL,
+			"operation":  job.Operation,
+		},
+	})
+}
+
+func getPendingJob(d *schema.ResourceData) *AsyncJob {
+	tfPendingJob := d.Get(pendingJobFieldName).([]interface{})
+	if len(tfPendingJob) == 0 || tfPendingJob[0] == nil {
+		return nil
+	}
+	pendingJob := tfPendingJob[0].(map[string]interface{})
+	return &AsyncJob{
+		ID:        pendingJob["id"].(string),
+		StatusURL: pendingJob["status_url"].(string),
+		Operation: pendingJob["operation"].(string),
+	}
+}
+
+// completeAsyncJob waits for a job started by the create or update of a resource. It returns whether the
+// job completed. The job is recorded in the state of the resource before waiting, so that if it is still in
+// progress when the timeout expires or Terraform is interrupted, the next refresh resumes waiting for the job
+// instead of the next apply starting another one. A pending job is reported as a warning, so that a resource
+// whose create is still in progress is not tainted and replaced.
+func completeAsyncJob(ctx context.Context, d *schema.ResourceData, job AsyncJob, timeout time.Duration,
+	wait asyncJobWaitFunc) (bool, diag.Diagnostics) {
+
+	if d.Id() == "" {
+		// The resource doesn't have an id until the job completes, but it must have one to be saved.
+		d.SetId(job.ID)
+	}
+	setPendingJob(d, &job)
+
+	id, err := wait(ctx, job, timeout)
+	var pendingErr *AsyncJobPendingError
+	if errors.As(err, &pendingErr) {
+		return false, diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Async job %s is still in progress", job.ID),
+			Detail: fmt.Sprintf("%s. Waiting for the job is resumed when the resource is refreshed.",
+				pendingErr.Error()),
+		}}
+	}
+
+	setPendingJob(d, nil)
+	if err != nil {
+		if job.Operation == "create" {
+			// The resource was never created.
+			d.SetId("")
+		}
+		return false, diag.FromErr(err)
+	}
+
+	if id != "" {
+		d.SetId(id)
+	}
+	return true, nil
+}
+
+// resumeAsyncJob resumes waiting for the job recorded in the state of the resource, if any. It returns
+// whether the resource exists and can be read.
+func resumeAsyncJob(ctx context.Context, d *schema.ResourceData, timeout time.Duration,
+	wait asyncJobWaitFunc) (bool, diag.Diagnostics) {
+
+	job := getPendingJob(d)
+	if job == nil {
+		return true, nil
+	}
+
+	log.Printf("[INFO] Resuming wait for async job %s of the %s of %s", job.ID, job.Operation, d.Id())
+	id, err := wait(ctx, *job, timeout)
+	var pendingErr *AsyncJobPendingError
+	if errors.As(err, &pendingErr) {
+		return false, diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Async job %s is still in progress", job.ID),
+			Detail:   pendingErr.Error(),
+		}}
+	}
+
+	setPendingJob(d, nil)
+	if err != nil {
+		if job.Operation == "create" {
+			// The resource was never created, so it is created again by the next apply.
+			d.SetId("")
+			return false, diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Async job %s of the create failed", job.ID),
+				Detail:   err.Error(),
+			}}
+		}
+		return true, diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Async job %s of the %s failed", job.ID, job.Operation),
+			Detail:   err.Error(),
+		}}
+	}
+
+	if id != "" {
+		d.SetId(id)
+	}
+	return true, nil
+}
@@ -0,0 +1,157 @@
+package sumologic
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+const exampleContentImportStatusURL = "v2/content/folders/0000000000000001/import/C03E086C137F38B4/status"
+
+func exampleContentImportJob(operation string) AsyncJob {
+	return AsyncJob{
+		ID:        "C03E086C137F38B4",
+		StatusURL: exampleContentImportStatusURL,
+		Operation: operation,
+	}
+}
+
+func TestWaitForAsyncJob(t *testing.T) {
+	client, _ := newRoutingTestClient(map[string]string{
+		exampleContentImportStatusURL: `{"status": "Success", "statusMessage": "Import succeeded:0000000000000004"}`,
+	})
+	id, err := client.WaitForContentImport(context.Background(), exampleContentImportJob(""), time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "0000000000000004" {
+		t.Errorf("expected id of imported content to be 0000000000000004, got %s", id)
+	}
+
+	client, _ = newRoutingTestClient(map[string]string{
+		exampleContentImportStatusURL: `{"status": "Failed", "error": {"code": "content:invalid", "message": "Invalid content"}}`,
+	})
+	_, err = client.WaitForAsyncJob(context.Background(), exampleContentImportJob(""), time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "async job failed") {
+		t.Errorf("expected failed job error, got %v", err)
+	}
+}
+
+func TestWaitForAsyncJob_pending(t *testing.T) {
+	client, _ := newRoutingTestClient(map[string]string{
+		exampleContentImportStatusURL: `{"status": "InProgress"}`,
+	})
+
+	_, err := client.WaitForAsyncJob(context.Background(), exampleContentImportJob(""), 10*time.Millisecond)
+	var pendingErr *AsyncJobPendingError
+	if !errors.As(err, &pendingErr) || pendingErr.Job.ID != "C03E086C137F38B4" {
+		t.Errorf("expected pending job error after timeout, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.WaitForAsyncJob(ctx, exampleContentImportJob(""), time.Minute)
+	if !errors.As(err, &pendingErr) || !errors.Is(err, context.Canceled) {
+		t.Errorf("expected pending job error after cancellation, got %v", err)
+	}
+}
+
+func TestCompleteAsyncJob_pending(t *testing.T) {
+	client, _ := newRoutingTestClient(map[string]string{
+		exampleContentImportStatusURL: `{"status": "InProgress"}`,
+	})
+	d := resourceSumologicContent().Data(nil)
+
+	completed, diags := completeAsyncJob(context.Background(), d, exampleContentImportJob("create"), 10*time.Millisecond,
+		client.WaitForContentImport)
+	if completed || len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Errorf("expected a warning for the pending job, so that the resource is not tainted, got %v", diags)
+	}
+	if d.Id() != "C03E086C137F38B4" {
+		t.Errorf("expected the job id to be used as id until the job completes, got %s", d.Id())
+	}
+	if job := getPendingJob(d); job == nil || *job != exampleContentImportJob("create") {
+		t.Errorf("expected the job to be recorded, got %+v", job)
+	}
+}
+
+func TestCompleteAsyncJob_recordsJobBeforeWaiting(t *testing.T) {
+	d := resourceSumologicContent().Data(nil)
+	job := exampleContentImportJob("create")
+
+	completed, diags := completeAsyncJob(context.Background(), d, job, time.Minute,
+		func(ctx context.Context, waited AsyncJob, timeout time.Duration) (string, error) {
+			if d.Id() != job.ID || getPendingJob(d) == nil {
+				t.Errorf("expected the job to be recorded before waiting, got id %s and job %+v", d.Id(), getPendingJob(d))
+			}
+			return "0000000000000004", nil
+		})
+	if !completed || len(diags) != 0 {
+		t.Errorf("expected the job to complete, got %v", diags)
+	}
+	if d.Id() != "0000000000000004" || getPendingJob(d) != nil {
+		t.Errorf("expected id of the imported content and no pending job, got %s, %+v", d.Id(), getPendingJob(d))
+	}
+}
+
+func TestCompleteAsyncJob_failedCreate(t *testing.T) {
+	client, _ := newRoutingTestClient(map[string]string{
+		exampleContentImportStatusURL: `{"status": "Failed", "error": {"code": "content:invalid", "message": "Invalid content"}}`,
+	})
+	d := resourceSumologicContent().Data(nil)
+
+	completed, diags := completeAsyncJob(context.Background(), d, exampleContentImportJob("create"), time.Minute,
+		client.WaitForContentImport)
+	if completed || !diags.HasError() {
+		t.Errorf("expected an error for the failed job, got %v", diags)
+	}
+	if d.Id() != "" || getPendingJob(d) != nil {
+		t.Errorf("expected content that was never created not to be saved, got id %s, job %+v", d.Id(), getPendingJob(d))
+	}
+}
+
+func TestResumeAsyncJob(t *testing.T) {
+	client, _ := newRoutingTestClient(map[string]string{
+		exampleContentImportStatusURL: `{"status": "Success", "statusMessage": "Import succeeded:0000000000000004"}`,
+	})
+	d := resourceSumologicContent().Data(nil)
+	d.SetId("C03E086C137F38B4")
+	job := exampleContentImportJob("create")
+	setPendingJob(d, &job)
+
+	found, diags := resumeAsyncJob(context.Background(), d, time.Minute, client.WaitForContentImport)
+	if !found || len(diags) != 0 {
+		t.Errorf("expected the resumed job to complete, got %v", diags)
+	}
+	if d.Id() != "0000000000000004" || getPendingJob(d) != nil {
+		t.Errorf("expected id of the imported content and no pending job, got %s, %+v", d.Id(), getPendingJob(d))
+	}
+
+	// Resuming without a pending job doesn't request anything.
+	found, diags = resumeAsyncJob(context.Background(), d, time.Minute, client.WaitForContentImport)
+	if !found || len(diags) != 0 {
+		t.Errorf("expected nothing to resume, got %v", diags)
+	}
+}
+
+func TestResumeAsyncJob_failedCreate(t *testing.T) {
+	client, _ := newRoutingTestClient(map[string]string{
+		exampleContentImportStatusURL: `{"status": "Failed", "error": {"code": "content:invalid", "message": "Invalid content"}}`,
+	})
+	d := resourceSumologicContent().Data(nil)
+	d.SetId("C03E086C137F38B4")
+	job := exampleContentImportJob("create")
+	setPendingJob(d, &job)
+
+	found, diags := resumeAsyncJob(context.Background(), d, time.Minute, client.WaitForContentImport)
+	if found || diags.HasError() || len(diags) != 1 {
+		t.Errorf("expected a warning for the failed job, got %v", diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected content that was never created to be removed from state, got id %s", d.Id())
+	}
+}
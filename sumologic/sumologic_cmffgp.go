@@ -7,6 +7,21 @@ import (
 )
 
 func (s *Client) GetCmfFgp(targetType string, targetId string) (*CmfFgpResponse, error) {
+	cmfFgpResponse, err := s.GetCmfFgpAllSubjects(targetType, targetId)
+	if err != nil || cmfFgpResponse == nil {
+		return cmfFgpResponse, err
+	}
+
+	// Filter subjectType "user"
+	cmfFgpResponse.PermissionStatements = cmfFgpFilter(cmfFgpResponse.PermissionStatements,
+		func(perm *CmfFgpPermStatement) bool {
+			return perm.SubjectType != "user"
+		})
+	return cmfFgpResponse, nil
+}
+
+// GetCmfFgpAllSubjects is GetCmfFgp without filtering out the statements of "user" subjects.
+func (s *Client) GetCmfFgpAllSubjects(targetType string, targetId string) (*CmfFgpResponse, error) {
 
 	// e.g. "v1/monitors/0000000000000003/permissions"
 	url := fmt.Sprintf("v1/%s/%s/permissions", targetType, targetId)
@@ -23,12 +38,6 @@ func (s *Client) GetCmfFgp(targetType string, targetId string) (*CmfFgpResponse,
 	if err != nil {
 		return nil, err
 	}
-
-	// Filter subjectType "user"
-	cmfFgpResponse.PermissionStatements = cmfFgpFilter(cmfFgpResponse.PermissionStatements,
-		func(perm *CmfFgpPermStatement) bool {
-			return perm.SubjectType != "user"
-		})
 	return &cmfFgpResponse, nil
 }
 
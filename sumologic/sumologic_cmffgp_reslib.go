@@ -37,13 +37,30 @@ func GetCmfFgpObjPermSetSchema() *schema.Schema {
 			Schema: GetCmfFgpPermStmtSchema(),
 		},
 		// NOTE(2022-05-04): ValidateFunc is not yet supported on lists or sets
+		Optional:      true,
+		ConflictsWith: []string{"obj_permission_managed_externally"},
+	}
+}
+
+// GetCmfFgpObjPermManagedExternallySchema opts out of managing the explicit permissions of an object, so that
+// they can be managed by a sumologic_cmf_permissions resource instead of being revoked on every apply.
+func GetCmfFgpObjPermManagedExternallySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
 		Optional: true,
+		Default:  false,
 	}
 }
 
+func CmfFgpObjPermManagedExternally(d *schema.ResourceData) bool {
+	return d.Get("obj_permission_managed_externally").(bool)
+}
+
 func ResourceToCmfFgpPermStmts(d *schema.ResourceData, targetId string) ([]CmfFgpPermStatement, error) {
-	permStmtResourceList := d.Get("obj_permission").(*schema.Set).List()
+	return CmfFgpResourceListToPermStmts(d.Get("obj_permission").(*schema.Set).List(), targetId)
+}
 
+func CmfFgpResourceListToPermStmts(permStmtResourceList []interface{}, targetId string) ([]CmfFgpPermStatement, error) {
 	var result []CmfFgpPermStatement
 	for i := range permStmtResourceList {
 		permStmtMap := permStmtResourceList[i].(map[string]interface{})
@@ -72,7 +89,10 @@ func ResourceToCmfFgpPermStmts(d *schema.ResourceData, targetId string) ([]CmfFg
 }
 
 func CmfFgpPermStmtsSetToResource(d *schema.ResourceData, permStmts []CmfFgpPermStatement) {
+	d.Set("obj_permission", CmfFgpPermStmtsToResourceList(permStmts))
+}
 
+func CmfFgpPermStmtsToResourceList(permStmts []CmfFgpPermStatement) []map[string]interface{} {
 	var permStmtResources []map[string]interface{}
 	for i := range permStmts {
 		permStmt := permStmts[i]
@@ -82,7 +102,7 @@ func CmfFgpPermStmtsSetToResource(d *schema.ResourceData, permStmts []CmfFgpPerm
 		permStmtResource["permissions"] = permStmt.Permissions
 		permStmtResources = append(permStmtResources, permStmtResource)
 	}
-	d.Set("obj_permission", permStmtResources)
+	return permStmtResources
 }
 
 func ReconcileFgpPermStmtsWithEmptyPerms(tfResourcePermStmts []CmfFgpPermStatement,
@@ -1,15 +1,17 @@
 package sumologic
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
 )
 
-func (s *Client) GetContent(id string, timeout time.Duration) (*Content, error) {
+func (s *Client) GetContent(ctx context.Context, id string, timeout time.Duration) (*Content, error) {
 	url := fmt.Sprintf("v2/content/%s/export", id)
 	log.Printf("[DEBUG] Exporting content with id: %s", id)
 
@@ -31,7 +33,7 @@ func (s *Client) GetContent(id string, timeout time.Duration) (*Content, error)
 
 	// Wait for export job to finish
 	url = fmt.Sprintf("v2/content/%s/export/%s/status", id, jid.ID)
-	_, err = waitForJob(url, timeout, s)
+	_, err = s.WaitForAsyncJob(ctx, AsyncJob{ID: jid.ID, StatusURL: url}, timeout)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +57,91 @@ func (s *Client) GetContent(id string, timeout time.Duration) (*Content, error)
 	return &content, nil
 }
 
-func (s *Client) DeleteContent(id string, timeout time.Duration) error {
+// GetContentByPath returns the item of the content library with the given path, or nil if it does not exist.
+func (s *Client) GetContentByPath(path string) (*ContentItem, error) {
+	data, err := s.Get(fmt.Sprintf("v2/content/path?path=%s", url.QueryEscape(path)))
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	var content ContentItem
+	err = json.Unmarshal(data, &content)
+	if err != nil {
+		return nil, err
+	}
+	if len(content.ID) == 0 {
+		return nil, nil
+	}
+	return &content, nil
+}
+
+// GetContentItem returns the item of the content library with the given id, including its folder, or
+// nil if it does not exist.
+func (s *Client) GetContentItem(id string) (*ContentItem, error) {
+	path, err := s.getContentPath(id)
+	if err != nil || path == "" {
+		return nil, err
+	}
+	return s.GetContentByPath(path)
+}
+
+// StartContentCopy starts a job that copies the content with the given id, and all of its children, into
+// the destination folder.
+func (s *Client) StartContentCopy(id string, destinationFolderId string) (AsyncJob, error) {
+	url := fmt.Sprintf("v2/content/%s/copy?destinationFolder=%s", id, destinationFolderId)
+	log.Printf("[DEBUG] Copying content with id: %s to folder: %s", id, destinationFolderId)
+
+	rawJID, err := s.Post(url, nil)
+	if err != nil {
+		return AsyncJob{}, err
+	}
+
+	var jid JobId
+	err = json.Unmarshal(rawJID, &jid)
+	if err != nil {
+		return AsyncJob{}, err
+	}
+	log.Printf("[DEBUG] Copy job id: %s", jid.ID)
+
+	return AsyncJob{
+		ID:        jid.ID,
+		StatusURL: fmt.Sprintf("v2/content/%s/copy/%s/status", id, jid.ID),
+	}, nil
+}
+
+// WaitForContentCopy waits for a content copy job and returns the id of the copy.
+func (s *Client) WaitForContentCopy(ctx context.Context, job AsyncJob, timeout time.Duration) (string, error) {
+	_, err := s.WaitForAsyncJob(ctx, job, timeout)
+	if err != nil {
+		return "", err
+	}
+
+	rawContent, err := s.Get(strings.TrimSuffix(job.StatusURL, "/status") + "/result")
+	if err != nil {
+		return "", err
+	}
+
+	var content ContentItem
+	err = json.Unmarshal(rawContent, &content)
+	if err != nil {
+		return "", err
+	}
+	return content.ID, nil
+}
+
+// MoveContent moves the content with the given id, and all of its children, into the destination folder.
+func (s *Client) MoveContent(id string, destinationFolderId string) error {
+	url := fmt.Sprintf("v2/content/%s/move?destinationFolderId=%s", id, destinationFolderId)
+	log.Printf("[DEBUG] Moving content with id: %s to folder: %s", id, destinationFolderId)
+
+	_, err := s.Post(url, nil)
+	return err
+}
+
+func (s *Client) DeleteContent(ctx context.Context, id string, timeout time.Duration) error {
 	log.Printf("[DEBUG] Deleting content with id: %s", id)
 	url := fmt.Sprintf("v2/content/%s/delete", id)
 
@@ -72,33 +158,45 @@ func (s *Client) DeleteContent(id string, timeout time.Duration) error {
 	log.Printf("[DEBUG] Delete job id: %s", jid.ID)
 
 	url = fmt.Sprintf("v2/content/%s/delete/%s/status", id, jid.ID)
-	_, err = waitForJob(url, timeout, s)
+	_, err = s.WaitForAsyncJob(ctx, AsyncJob{ID: jid.ID, StatusURL: url}, timeout)
 	return err
 }
 
-func (s *Client) CreateOrUpdateContent(content Content, timeout time.Duration, overwrite bool) (string, error) {
+// StartContentImport starts a job that imports the content into its parent folder. If overwrite is
+// true, content with the same name in the folder is replaced.
+func (s *Client) StartContentImport(content Content, overwrite bool) (AsyncJob, error) {
 	url := fmt.Sprintf("v2/content/folders/%s/import?overwrite=%s", content.ParentId, strconv.FormatBool(overwrite))
 	log.Printf("[DEBUG] Import content in folder=%s, overwrite=%t", content.ParentId, overwrite)
 
 	jobResponse, err := s.PostRawPayload(url, content.Config)
 	if err != nil {
-		return "", err
+		return AsyncJob{}, err
 	}
 
 	var jid JobId
 	err = json.Unmarshal(jobResponse, &jid)
 	if err != nil {
-		return "", err
+		return AsyncJob{}, err
 	}
 	log.Printf("[DEBUG] Import content job id: %s", jid.ID)
 
-	url = fmt.Sprintf("v2/content/folders/%s/import/%s/status", content.ParentId, jid.ID)
-	status, err := waitForJob(url, timeout, s)
+	return AsyncJob{
+		ID:        jid.ID,
+		StatusURL: fmt.Sprintf("v2/content/folders/%s/import/%s/status", content.ParentId, jid.ID),
+	}, nil
+}
+
+// WaitForContentImport waits for a content import job and returns the id of the imported content.
+func (s *Client) WaitForContentImport(ctx context.Context, job AsyncJob, timeout time.Duration) (string, error) {
+	status, err := s.WaitForAsyncJob(ctx, job, timeout)
 	if err != nil {
 		return "", err
 	}
 
 	// extract id of newly created content
-	contentId := strings.Split(status.StatusMessage, ":")[1]
-	return contentId, nil
+	parts := strings.Split(status.StatusMessage, ":")
+	if len(parts) < 2 {
+		return "", fmt.Errorf("unexpected status message of content import job %s: %s", job.ID, status.StatusMessage)
+	}
+	return parts[1], nil
 }
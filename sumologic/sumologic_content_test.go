@@ -0,0 +1,63 @@
+package sumologic
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestStartContentCopy(t *testing.T) {
+	client, httpClient := newRoutingTestClient(map[string]string{
+		"v2/content/0000000000000004/copy":                         `{"id": "C03E086C137F38B4"}`,
+		"v2/content/0000000000000004/copy/C03E086C137F38B4/status": `{"status": "Success", "statusMessage": null}`,
+		"v2/content/0000000000000004/copy/C03E086C137F38B4/result": `{
+			"id": "0000000000000007",
+			"name": "Api Health",
+			"itemType": "Dashboard",
+			"parentId": "0000000000000002"
+		}`,
+	})
+
+	job, err := client.StartContentCopy("0000000000000004", "0000000000000002")
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := client.WaitForContentCopy(context.Background(), job, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "0000000000000007" {
+		t.Errorf("expected id of the copy to be 0000000000000007, got %s", id)
+	}
+	if httpClient.requests[0] != "v2/content/0000000000000004/copy" {
+		t.Errorf("expected copy job to be started first, got requests %v", httpClient.requests)
+	}
+}
+
+func TestGetContentItem(t *testing.T) {
+	client, httpClient := newRoutingTestClient(map[string]string{
+		"v2/content/0000000000000004/path": `{"path": "/Library/Users/user@example.com/Api Health"}`,
+		"v2/content/path": `{
+			"id": "0000000000000004",
+			"name": "Api Health",
+			"itemType": "Dashboard",
+			"parentId": "0000000000000001"
+		}`,
+	})
+
+	content, err := client.GetContentItem("0000000000000004")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content == nil || content.ParentId != "0000000000000001" || content.ItemType != "Dashboard" {
+		t.Errorf("unexpected content %+v", content)
+	}
+	if len(httpClient.requests) != 2 {
+		t.Errorf("expected the path of the content to be looked up, got requests %v", httpClient.requests)
+	}
+
+	content, err = client.GetContentItem("0000000000000005")
+	if err != nil || content != nil {
+		t.Errorf("expected no content for an id that does not exist, got %+v, %v", content, err)
+	}
+}
@@ -0,0 +1,150 @@
+package sumologic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// ContentItem is a folder, or an item of a folder, of the content library.
+type ContentItem struct {
+	ID          string        `json:"id"`
+	Name        string        `json:"name"`
+	Description string        `json:"description"`
+	ItemType    string        `json:"itemType"`
+	ParentId    string        `json:"parentId"`
+	CreatedBy   string        `json:"createdBy,omitempty"`
+	Children    []ContentItem `json:"children,omitempty"`
+}
+
+// ContentTreeItem is an item of a folder hierarchy, with its path in the content library.
+type ContentTreeItem struct {
+	ID       string
+	Name     string
+	ItemType string
+	Path     string
+	ParentId string
+	Depth    int
+}
+
+// ContentTreeFilter selects the items returned by GetContentTree. Folders are always traversed, even
+// if they are not selected themselves.
+type ContentTreeFilter struct {
+	ItemTypes []string
+	NameRegex *regexp.Regexp
+	// MaxDepth is the number of levels below the root folder to list, 0 for all levels.
+	MaxDepth int
+}
+
+func (f ContentTreeFilter) matches(item ContentTreeItem) bool {
+	if len(f.ItemTypes) > 0 && !contains(f.ItemTypes, item.ItemType) {
+		return false
+	}
+	if f.NameRegex != nil && !f.NameRegex.MatchString(item.Name) {
+		return false
+	}
+	return true
+}
+
+func (s *Client) getFolderContent(id string) (*ContentItem, error) {
+	url := fmt.Sprintf("v2/content/folders/%s", id)
+	data, err := s.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, fmt.Errorf("folder with id '%s' does not exist", id)
+	}
+
+	var folder ContentItem
+	err = json.Unmarshal(data, &folder)
+	if err != nil {
+		return nil, err
+	}
+	return &folder, nil
+}
+
+func (s *Client) getAdminRecommendedFolderContent(ctx context.Context, timeout time.Duration) (*ContentItem, error) {
+	data, err := s.getAdminRecommendedFolderResult(ctx, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	var folder ContentItem
+	err = json.Unmarshal(data, &folder)
+	if err != nil {
+		return nil, err
+	}
+	return &folder, nil
+}
+
+// GetContentTree lists the items below the folder with the given id recursively, depth first. If
+// adminRecommended is true, the listing starts at the Admin Recommended folder instead, which is read
+// with an async job. It returns the root folder and the items that match the filter.
+func (s *Client) GetContentTree(ctx context.Context, id string, adminRecommended bool, filter ContentTreeFilter,
+	timeout time.Duration) (*ContentTreeItem, []ContentTreeItem, error) {
+
+	var folder *ContentItem
+	var err error
+	if adminRecommended {
+		folder, err = s.getAdminRecommendedFolderContent(ctx, timeout)
+	} else {
+		folder, err = s.getFolderContent(id)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	path, err := s.GetContentPath(folder.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	root := ContentTreeItem{
+		ID:       folder.ID,
+		Name:     folder.Name,
+		ItemType: "Folder",
+		Path:     path,
+		ParentId: folder.ParentId,
+	}
+
+	items, err := s.listContentTree(folder, root, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &root, items, nil
+}
+
+func (s *Client) listContentTree(folder *ContentItem, parent ContentTreeItem,
+	filter ContentTreeFilter) ([]ContentTreeItem, error) {
+
+	var items []ContentTreeItem
+	for _, child := range folder.Children {
+		item := ContentTreeItem{
+			ID:       child.ID,
+			Name:     child.Name,
+			ItemType: child.ItemType,
+			Path:     parent.Path + "/" + child.Name,
+			ParentId: parent.ID,
+			Depth:    parent.Depth + 1,
+		}
+		if filter.matches(item) {
+			items = append(items, item)
+		}
+
+		if child.ItemType != "Folder" || (filter.MaxDepth > 0 && item.Depth >= filter.MaxDepth) {
+			continue
+		}
+		subFolder, err := s.getFolderContent(child.ID)
+		if err != nil {
+			return nil, err
+		}
+		subItems, err := s.listContentTree(subFolder, item, filter)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, subItems...)
+	}
+	return items, nil
+}
@@ -0,0 +1,77 @@
+package sumologic
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func exampleContentTreeClient() (*Client, *mockRoutingHttpClient) {
+	return newRoutingTestClient(map[string]string{
+		"v2/content/folders/0000000000000001": `{
+			"id": "0000000000000001", "name": "Apps", "parentId": "0000000000000000", "children": [
+				{"id": "0000000000000002", "name": "Api", "itemType": "Folder", "parentId": "0000000000000001"},
+				{"id": "0000000000000003", "name": "Api Health", "itemType": "Dashboard", "parentId": "0000000000000001"}
+			]}`,
+		"v2/content/folders/0000000000000002": `{
+			"id": "0000000000000002", "name": "Api", "parentId": "0000000000000001", "children": [
+				{"id": "0000000000000004", "name": "Api Errors", "itemType": "Search", "parentId": "0000000000000002"},
+				{"id": "0000000000000005", "name": "Archive", "itemType": "Folder", "parentId": "0000000000000002"}
+			]}`,
+		"v2/content/folders/0000000000000005": `{
+			"id": "0000000000000005", "name": "Archive", "parentId": "0000000000000002", "children": [
+				{"id": "0000000000000006", "name": "Old Api Errors", "itemType": "Search", "parentId": "0000000000000005"}
+			]}`,
+		"v2/content/0000000000000001/path": `{"path": "/Library/Users/user@example.com/Apps"}`,
+	})
+}
+
+func TestGetContentTree(t *testing.T) {
+	client, _ := exampleContentTreeClient()
+
+	root, items, err := client.GetContentTree(context.Background(), "0000000000000001", false, ContentTreeFilter{}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root.ID != "0000000000000001" || root.Path != "/Library/Users/user@example.com/Apps" {
+		t.Errorf("unexpected root %+v", root)
+	}
+
+	expectedItems := []ContentTreeItem{
+		{ID: "0000000000000002", Name: "Api", ItemType: "Folder", Path: "/Library/Users/user@example.com/Apps/Api",
+			ParentId: "0000000000000001", Depth: 1},
+		{ID: "0000000000000004", Name: "Api Errors", ItemType: "Search",
+			Path: "/Library/Users/user@example.com/Apps/Api/Api Errors", ParentId: "0000000000000002", Depth: 2},
+		{ID: "0000000000000005", Name: "Archive", ItemType: "Folder",
+			Path: "/Library/Users/user@example.com/Apps/Api/Archive", ParentId: "0000000000000002", Depth: 2},
+		{ID: "0000000000000006", Name: "Old Api Errors", ItemType: "Search",
+			Path: "/Library/Users/user@example.com/Apps/Api/Archive/Old Api Errors", ParentId: "0000000000000005", Depth: 3},
+		{ID: "0000000000000003", Name: "Api Health", ItemType: "Dashboard",
+			Path: "/Library/Users/user@example.com/Apps/Api Health", ParentId: "0000000000000001", Depth: 1},
+	}
+	if !reflect.DeepEqual(expectedItems, items) {
+		t.Errorf("expected items\n%+v\ngot\n%+v", expectedItems, items)
+	}
+}
+
+func TestGetContentTree_filter(t *testing.T) {
+	client, httpClient := exampleContentTreeClient()
+
+	filter := ContentTreeFilter{
+		ItemTypes: []string{"Search"},
+		NameRegex: regexp.MustCompile("^Api"),
+		MaxDepth:  2,
+	}
+	_, items, err := client.GetContentTree(context.Background(), "0000000000000001", false, filter, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 1 || items[0].ID != "0000000000000004" {
+		t.Errorf("expected only the Api Errors search, got %+v", items)
+	}
+	// Folders below max_depth are not listed.
+	if contains(httpClient.requests, "v2/content/folders/0000000000000005") {
+		t.Errorf("expected folder below max depth not to be requested, got requests %v", httpClient.requests)
+	}
+}
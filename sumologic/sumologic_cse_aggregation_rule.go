@@ -134,6 +134,7 @@ type CSEAggregationRule struct {
 	WindowSizeName         string                `json:"windowSizeName,omitempty"`
 	WindowSizeMilliseconds string                `json:"windowSizeMilliseconds,omitempty"`
 	SuppressionWindowSize  *int                  `json:"suppressionWindowSize,omitempty"`
+	RuleSource             string                `json:"ruleSource,omitempty"`
 }
 
 type CSEAggregationRuleOverride struct {
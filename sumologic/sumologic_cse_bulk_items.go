@@ -0,0 +1,425 @@
+package sumologic
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// cseBulkBatchSize is the number of items created or deleted by a request of the bulk APIs.
+const cseBulkBatchSize = 1000
+
+// sendCSEBatches calls send with consecutive batches of at most cseBulkBatchSize items.
+func sendCSEBatches[T any](items []T, send func([]T) error) error {
+	for start := 0; start < len(items); start += cseBulkBatchSize {
+		end := start + cseBulkBatchSize
+		if end > len(items) {
+			end = len(items)
+		}
+		if err := send(items[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cseBulkItems implements the resources that manage the items of a container in bulk, such as the items
+// of a match list. Their state doesn't hold the items themselves but a digest of them, which is compared
+// with the digest of the configured items to detect changes, and the values of the items they manage,
+// managed_values. T is the type of the configured items and E the type of the items of the container.
+type cseBulkItems[T any, E any] struct {
+	// itemName and containerName name the items and their container in messages.
+	itemName      string
+	containerName string
+	// containerField is the argument with the id of the container, itemsField the block of configured
+	// items, and sha256Field and countField the attributes with the digest and the number of items.
+	containerField string
+	itemsField     string
+	sha256Field    string
+	countField     string
+
+	// parsers read the items of source_file, by source_format.
+	parsers map[string]func(io.Reader) ([]T, error)
+	// expandItem returns an item of the itemsField block.
+	expandItem func(map[string]interface{}) T
+	// value returns the value that identifies an item, and line the line of the item in the digest.
+	value func(T) string
+	line  func(T) string
+	// expired returns whether an item has expired, if items can expire. Configured items that have expired
+	// are skipped, as the container removes them.
+	expired func(T, time.Time) bool
+	// existingItem returns the id of an item of the container and the item as it is configured.
+	existingItem func(E) (string, T)
+
+	// listItems returns the items of a container, or nil if the container doesn't exist.
+	listItems   func(c *Client, id string) ([]E, error)
+	createItems func(c *Client, items []T, id string) error
+	deleteItems func(c *Client, ids []string, id string) error
+}
+
+func (b cseBulkItems[T, E]) customizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown(b.itemsField) || !d.NewValueKnown("source_file") || !d.NewValueKnown("source_format") {
+		d.SetNewComputed(b.sha256Field)
+		d.SetNewComputed(b.countField)
+		return d.SetNewComputed("managed_values")
+	}
+
+	items, err := b.expand(d.Get)
+	if err != nil {
+		return err
+	}
+
+	itemsSha256 := b.hash(items)
+	if d.Get(b.sha256Field).(string) == itemsSha256 && !d.HasChange("exclusive") {
+		return nil
+	}
+	d.SetNew(b.sha256Field, itemsSha256)
+	d.SetNew(b.countField, len(items))
+	return d.SetNewComputed("managed_values")
+}
+
+func (b cseBulkItems[T, E]) read(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	id := d.Id()
+	existingItems, err := b.listItems(c, id)
+	if err != nil {
+		return fmt.Errorf("[ERROR] %ss not found when looking by %s id %s, err: %v", b.itemName, b.containerName, id, err)
+	}
+	if existingItems == nil {
+		log.Printf("[WARN] %s not found, removing its items from state: %v", b.containerName, id)
+		d.SetId("")
+		return nil
+	}
+
+	ownedItems := b.owned(existingItems, d.Get("managed_values").(*schema.Set), d.Get("exclusive").(bool))
+
+	d.Set(b.containerField, id)
+	d.Set(b.sha256Field, b.hash(ownedItems))
+	d.Set(b.countField, len(ownedItems))
+
+	return nil
+}
+
+func (b cseBulkItems[T, E]) create(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	d.SetId(d.Get(b.containerField).(string))
+	err := b.sync(d, c, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	return b.read(d, meta)
+}
+
+func (b cseBulkItems[T, E]) update(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	err := b.sync(d, c, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+
+	return b.read(d, meta)
+}
+
+func (b cseBulkItems[T, E]) delete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	id := d.Id()
+	existingItems, err := b.listItems(c, id)
+	if err != nil {
+		return fmt.Errorf("[ERROR] %ss not found when looking by %s id %s, err: %v", b.itemName, b.containerName, id, err)
+	}
+	if existingItems == nil {
+		log.Printf("[WARN] %s not found when deleting its items: %v", b.containerName, id)
+		return nil
+	}
+
+	managedValues := d.Get("managed_values").(*schema.Set)
+	exclusive := d.Get("exclusive").(bool)
+	var deleteIds []string
+	for _, existingItem := range existingItems {
+		existingId, item := b.existingItem(existingItem)
+		if exclusive || managedValues.Contains(b.value(item)) {
+			deleteIds = append(deleteIds, existingId)
+		}
+	}
+
+	log.Printf("[DEBUG] %s %s %ss to delete: %d", b.containerName, id, b.itemName, len(deleteIds))
+	return b.deleteItems(c, deleteIds, id)
+}
+
+// listExisting returns the items of a container that must exist.
+func (b cseBulkItems[T, E]) listExisting(c *Client, id string) ([]E, error) {
+	existingItems, err := b.listItems(c, id)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] %ss not found when looking by %s id %s, err: %v", b.itemName, b.containerName, id, err)
+	}
+	if existingItems == nil {
+		return nil, fmt.Errorf("[ERROR] %s with id %s does not exist", b.containerName, id)
+	}
+	return existingItems, nil
+}
+
+// sync applies the difference between the configured items and the items of the container in batches,
+// and waits until the container has the configured items.
+func (b cseBulkItems[T, E]) sync(d *schema.ResourceData, c *Client, timeout time.Duration) error {
+	id := d.Id()
+	items, err := b.expand(d.Get)
+	if err != nil {
+		return err
+	}
+
+	existingItems, err := b.listExisting(c, id)
+	if err != nil {
+		return err
+	}
+
+	// The managed values are computed anew, so the items managed so far are the ones of the state
+	oldManagedValues, _ := d.GetChange("managed_values")
+	exclusive := d.Get("exclusive").(bool)
+	addItems, deleteIds := b.diff(existingItems, items, oldManagedValues.(*schema.Set), exclusive)
+	log.Printf("[DEBUG] %s %s sync %ss - to add: %d, to delete: %d", b.containerName, id, b.itemName,
+		len(addItems), len(deleteIds))
+
+	if len(deleteIds) > 0 {
+		err = b.deleteItems(c, deleteIds, id)
+		if err != nil {
+			return fmt.Errorf("[ERROR] An error occurred while deleting %ss of %s with id %s, err: %v", b.itemName,
+				b.containerName, id, err)
+		}
+	}
+	if len(addItems) > 0 {
+		err = b.createItems(c, addItems, id)
+		if err != nil {
+			return fmt.Errorf("[ERROR] An error occurred while adding %ss to %s with id %s, err: %v", b.itemName,
+				b.containerName, id, err)
+		}
+	}
+
+	values := make([]interface{}, len(items))
+	for i, item := range items {
+		values[i] = b.value(item)
+	}
+	managedValues := schema.NewSet(schema.HashString, values)
+	d.Set("managed_values", managedValues)
+
+	// Wait for the items to be added and deleted
+	itemsSha256 := b.hash(items)
+	syncStateConf := &resource.StateChangeConf{
+		Target: []string{itemsSha256},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := b.listExisting(c, id)
+			if err != nil {
+				return 0, "", err
+			}
+			ownedItems := b.owned(resp, managedValues, exclusive)
+			log.Printf("[DEBUG] %s sync awaiting %d %ss, got %d", b.containerName, len(items), b.itemName, len(ownedItems))
+			return resp, b.hash(ownedItems), nil
+		},
+		Timeout:                   timeout,
+		Delay:                     5 * time.Second,
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 1,
+	}
+
+	_, err = syncStateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for %ss of %s with id %s to be synchronized: %s", b.itemName,
+			b.containerName, id, err)
+	}
+	return nil
+}
+
+// diff returns the items to add to the container and the ids of the items to delete from it. Items are
+// identified by their value, and changed items are deleted and added again. Items that aren't configured
+// are only deleted if they are managed or if exclusive is true.
+func (b cseBulkItems[T, E]) diff(existingItems []E, items []T, managedValues *schema.Set,
+	exclusive bool) ([]T, []string) {
+
+	itemsByValue := make(map[string]T, len(items))
+	for _, item := range items {
+		itemsByValue[b.value(item)] = item
+	}
+
+	unchangedValues := make(map[string]bool)
+	var deleteIds []string
+	for _, existingItem := range existingItems {
+		existingId, existing := b.existingItem(existingItem)
+		value := b.value(existing)
+		item, ok := itemsByValue[value]
+		if ok && !unchangedValues[value] && b.line(existing) == b.line(item) {
+			unchangedValues[value] = true
+			continue
+		}
+		if ok || exclusive || managedValues.Contains(value) {
+			deleteIds = append(deleteIds, existingId)
+		}
+	}
+
+	var addItems []T
+	for _, item := range items {
+		if !unchangedValues[b.value(item)] {
+			addItems = append(addItems, item)
+		}
+	}
+	return addItems, deleteIds
+}
+
+// owned returns the items of the container that the resource manages, all of them if exclusive is true.
+func (b cseBulkItems[T, E]) owned(existingItems []E, managedValues *schema.Set, exclusive bool) []T {
+	var items []T
+	for _, existingItem := range existingItems {
+		_, item := b.existingItem(existingItem)
+		if exclusive || managedValues.Contains(b.value(item)) {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// hash returns a digest of the items that doesn't depend on their order.
+func (b cseBulkItems[T, E]) hash(items []T) string {
+	lines := make([]string, len(items))
+	for i, item := range items {
+		lines[i] = b.line(item)
+	}
+	sort.Strings(lines)
+
+	hash := sha256.Sum256([]byte(strings.Join(lines, "\n")))
+	return hex.EncodeToString(hash[:])
+}
+
+// expand returns the configured items, read from source_file or from the items block. get is the Get
+// function of the ResourceData or ResourceDiff of the resource.
+func (b cseBulkItems[T, E]) expand(get func(string) interface{}) ([]T, error) {
+	var items []T
+	if sourceFile := get("source_file").(string); sourceFile != "" {
+		format := get("source_format").(string)
+		if format == "" {
+			format = strings.TrimPrefix(strings.ToLower(filepath.Ext(sourceFile)), ".")
+		}
+		parse, ok := b.parsers[format]
+		if !ok {
+			formats := make([]string, 0, len(b.parsers))
+			for f := range b.parsers {
+				formats = append(formats, f)
+			}
+			sort.Strings(formats)
+			return nil, fmt.Errorf("source_format must be set to one of %s for source_file %s",
+				strings.Join(formats, ", "), sourceFile)
+		}
+
+		f, err := os.Open(sourceFile)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		items, err = parse(f)
+		if err != nil {
+			return nil, fmt.Errorf("error reading %ss from %s: %v", b.itemName, sourceFile, err)
+		}
+	} else {
+		for _, data := range get(b.itemsField).(*schema.Set).List() {
+			items = append(items, b.expandItem(data.(map[string]interface{})))
+		}
+	}
+
+	values := make(map[string]bool, len(items))
+	for _, item := range items {
+		if values[b.value(item)] {
+			return nil, fmt.Errorf("duplicate %s value '%s'", b.itemName, b.value(item))
+		}
+		values[b.value(item)] = true
+	}
+
+	if b.expired == nil {
+		return items, nil
+	}
+	now := time.Now()
+	activeItems := make([]T, 0, len(items))
+	for _, item := range items {
+		if b.expired(item, now) {
+			log.Printf("[DEBUG] Skipping expired %s '%s'", b.itemName, b.value(item))
+			continue
+		}
+		activeItems = append(activeItems, item)
+	}
+	return activeItems, nil
+}
+
+// parseCSEBulkItemsCsv reads items from CSV with a header row, which must have the required columns.
+// parseRow returns the item of a row, given a function that returns the trimmed value of a column of
+// the row, or an empty string if the header row doesn't have the column.
+func parseCSEBulkItemsCsv[T any](r io.Reader, requiredColumns []string,
+	parseRow func(field func(column string) string) (T, error)) ([]T, error) {
+
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	columns := make(map[string]int)
+	for i, column := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	for _, column := range requiredColumns {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("the header row must have a %s column", column)
+		}
+	}
+
+	items := make([]T, 0, len(records)-1)
+	for i, record := range records[1:] {
+		item, err := parseRow(func(column string) string {
+			if i, ok := columns[column]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		})
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %v", i+2, err)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// parseCSEBulkItemsJson reads items from a JSON array of objects. normalize returns the item to manage
+// for an item that was read, or an error if it isn't valid.
+func parseCSEBulkItemsJson[T any](r io.Reader, normalize func(T) (T, error)) ([]T, error) {
+	var jsonItems []T
+	err := json.NewDecoder(r).Decode(&jsonItems)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]T, 0, len(jsonItems))
+	for i, item := range jsonItems {
+		item, err = normalize(item)
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %v", i, err)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
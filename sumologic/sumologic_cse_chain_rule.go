@@ -128,6 +128,7 @@ type CSEChainRule struct {
 	WindowSizeName         string               `json:"windowSizeName,omitempty"`
 	WindowSizeMilliseconds string               `json:"windowSizeMilliseconds,omitempty"`
 	SuppressionWindowSize  *int                 `json:"suppressionWindowSize,omitempty"`
+	RuleSource             string               `json:"ruleSource,omitempty"`
 }
 
 type CSEChainRuleOverride struct {
@@ -0,0 +1,872 @@
+package sumologic
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
+)
+
+// The kinds of objects of a content pack. Rules are of the kind <type>_rule, such as match_rule.
+const (
+	cseContentPackMatchList            = "match_list"
+	cseContentPackRuleTuningExpression = "rule_tuning_expression"
+	cseContentPackCustomInsight        = "custom_insight"
+	cseContentPackRuleKindSuffix       = "_rule"
+	cseContentPackObjectKeySeparator   = "/"
+)
+
+// cseRuleIdRegex matches the ids of rules, such as MATCH-S00001 for a built-in rule.
+var cseRuleIdRegex = regexp.MustCompile(`^[A-Z_]+-[SU]\d+$`)
+
+// CSEContentPack is a bundle of detection content applied as a whole: match lists, rules, the tuning
+// expressions of the rules and the custom insights chaining them. Tuning expressions and custom
+// insights reference the rules of the pack by name in their ruleIds.
+type CSEContentPack struct {
+	Name                  string                    `json:"name"`
+	Version               string                    `json:"version,omitempty"`
+	MatchLists            []CSEContentPackMatchList `json:"matchLists,omitempty"`
+	Rules                 []CSEContentPackRule      `json:"rules,omitempty"`
+	RuleTuningExpressions []CSERuleTuningExpression `json:"ruleTuningExpressions,omitempty"`
+	CustomInsights        []CSECustomInsight        `json:"customInsights,omitempty"`
+}
+
+type CSEContentPackMatchList struct {
+	CSEMatchListPost
+	Items []CSEMatchListItemPost `json:"items,omitempty"`
+}
+
+// CSEContentPackRule is a rule of any type, with the fields of the rules API of its type.
+type CSEContentPackRule struct {
+	Type   string
+	Name   string
+	Fields json.RawMessage
+}
+
+func (r *CSEContentPackRule) UnmarshalJSON(data []byte) error {
+	var header struct {
+		Type string `json:"type"`
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return err
+	}
+	r.Type = header.Type
+	r.Name = header.Name
+	r.Fields = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+func (r CSEContentPackRule) MarshalJSON() ([]byte, error) {
+	return r.Fields, nil
+}
+
+// cseContentPackRuleType creates, updates, reads and deletes the rules of one type of the rules API.
+type cseContentPackRuleType struct {
+	decode func(fields []byte) (interface{}, error)
+	create func(c *Client, rule interface{}) (string, error)
+	update func(c *Client, id string, rule interface{}) error
+	get    func(c *Client, id string) (interface{}, error)
+	delete func(c *Client, id string) error
+}
+
+var cseContentPackRuleTypes = map[string]cseContentPackRuleType{
+	"match": {
+		decode: func(fields []byte) (interface{}, error) {
+			var rule CSEMatchRule
+			err := decodeCSEContentPackJson(fields, &rule)
+			return rule, err
+		},
+		create: func(c *Client, rule interface{}) (string, error) {
+			return c.CreateCSEMatchRule(rule.(CSEMatchRule))
+		},
+		update: func(c *Client, id string, rule interface{}) error {
+			r := rule.(CSEMatchRule)
+			r.ID = id
+			return c.UpdateCSEMatchRule(r)
+		},
+		get: func(c *Client, id string) (interface{}, error) {
+			rule, err := c.GetCSEMatchRule(id)
+			if rule == nil {
+				return nil, err
+			}
+			return rule, err
+		},
+		delete: (*Client).DeleteCSEMatchRule,
+	},
+	"threshold": {
+		decode: func(fields []byte) (interface{}, error) {
+			var rule CSEThresholdRule
+			err := decodeCSEContentPackJson(fields, &rule)
+			return rule, err
+		},
+		create: func(c *Client, rule interface{}) (string, error) {
+			return c.CreateCSEThresholdRule(rule.(CSEThresholdRule))
+		},
+		update: func(c *Client, id string, rule interface{}) error {
+			r := rule.(CSEThresholdRule)
+			r.ID = id
+			return c.UpdateCSEThresholdRule(r)
+		},
+		get: func(c *Client, id string) (interface{}, error) {
+			rule, err := c.GetCSEThresholdRule(id)
+			if rule == nil {
+				return nil, err
+			}
+			return rule, err
+		},
+		delete: (*Client).DeleteCSEThresholdRule,
+	},
+	"aggregation": {
+		decode: func(fields []byte) (interface{}, error) {
+			var rule CSEAggregationRule
+			err := decodeCSEContentPackJson(fields, &rule)
+			return rule, err
+		},
+		create: func(c *Client, rule interface{}) (string, error) {
+			return c.CreateCSEAggregationRule(rule.(CSEAggregationRule))
+		},
+		update: func(c *Client, id string, rule interface{}) error {
+			r := rule.(CSEAggregationRule)
+			r.ID = id
+			return c.UpdateCSEAggregationRule(r)
+		},
+		get: func(c *Client, id string) (interface{}, error) {
+			rule, err := c.GetCSEAggregationRule(id)
+			if rule == nil {
+				return nil, err
+			}
+			return rule, err
+		},
+		delete: (*Client).DeleteCSEAggregationRule,
+	},
+	"chain": {
+		decode: func(fields []byte) (interface{}, error) {
+			var rule CSEChainRule
+			err := decodeCSEContentPackJson(fields, &rule)
+			return rule, err
+		},
+		create: func(c *Client, rule interface{}) (string, error) {
+			return c.CreateCSEChainRule(rule.(CSEChainRule))
+		},
+		update: func(c *Client, id string, rule interface{}) error {
+			r := rule.(CSEChainRule)
+			r.ID = id
+			return c.UpdateCSEChainRule(r)
+		},
+		get: func(c *Client, id string) (interface{}, error) {
+			rule, err := c.GetCSEChainRule(id)
+			if rule == nil {
+				return nil, err
+			}
+			return rule, err
+		},
+		delete: (*Client).DeleteCSEChainRule,
+	},
+	"first_seen": {
+		decode: func(fields []byte) (interface{}, error) {
+			var rule CSEFirstSeenRule
+			err := decodeCSEContentPackJson(fields, &rule)
+			return rule, err
+		},
+		create: func(c *Client, rule interface{}) (string, error) {
+			return c.CreateCSEFirstSeenRule(rule.(CSEFirstSeenRule))
+		},
+		update: func(c *Client, id string, rule interface{}) error {
+			r := rule.(CSEFirstSeenRule)
+			r.ID = id
+			return c.UpdateCSEFirstSeenRule(r)
+		},
+		get: func(c *Client, id string) (interface{}, error) {
+			rule, err := c.GetCSEFirstSeenRule(id)
+			if rule == nil {
+				return nil, err
+			}
+			return rule, err
+		},
+		delete: (*Client).DeleteCSEFirstSeenRule,
+	},
+	"outlier": {
+		decode: func(fields []byte) (interface{}, error) {
+			var rule CSEOutlierRule
+			err := decodeCSEContentPackJson(fields, &rule)
+			return rule, err
+		},
+		create: func(c *Client, rule interface{}) (string, error) {
+			return c.CreateCSEOutlierRule(rule.(CSEOutlierRule))
+		},
+		update: func(c *Client, id string, rule interface{}) error {
+			r := rule.(CSEOutlierRule)
+			r.ID = id
+			return c.UpdateCSEOutlierRule(r)
+		},
+		get: func(c *Client, id string) (interface{}, error) {
+			rule, err := c.GetCSEOutlierRule(id)
+			if rule == nil {
+				return nil, err
+			}
+			return rule, err
+		},
+		delete: (*Client).DeleteCSEOutlierRule,
+	},
+}
+
+// decodeCSEContentPackJson decodes JSON into v, rejecting the fields v doesn't have.
+func decodeCSEContentPackJson(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
+
+// ParseCSEContentPack parses a content pack document, in JSON or YAML, and validates it.
+func ParseCSEContentPack(content string) (*CSEContentPack, error) {
+	var document interface{}
+	if err := yaml.Unmarshal([]byte(content), &document); err != nil {
+		return nil, fmt.Errorf("invalid content pack document: %v", err)
+	}
+	data, err := json.Marshal(document)
+	if err != nil {
+		return nil, fmt.Errorf("invalid content pack document: %v", err)
+	}
+
+	var pack CSEContentPack
+	if err := decodeCSEContentPackJson(data, &pack); err != nil {
+		return nil, fmt.Errorf("invalid content pack document: %v", err)
+	}
+	if err := validateCSEContentPack(pack); err != nil {
+		return nil, err
+	}
+	return &pack, nil
+}
+
+func validateCSEContentPack(pack CSEContentPack) error {
+	if pack.Name == "" {
+		return fmt.Errorf("the content pack has no name")
+	}
+
+	names := make(map[string]bool)
+	checkName := func(kind string, name string) error {
+		if name == "" {
+			return fmt.Errorf("a %s of content pack %s has no name", strings.ReplaceAll(kind, "_", " "), pack.Name)
+		}
+		key := kind + cseContentPackObjectKeySeparator + name
+		if names[key] {
+			return fmt.Errorf("content pack %s has more than one %s named %s", pack.Name, strings.ReplaceAll(kind, "_", " "), name)
+		}
+		names[key] = true
+		return nil
+	}
+
+	for _, matchList := range pack.MatchLists {
+		if err := checkName(cseContentPackMatchList, matchList.Name); err != nil {
+			return err
+		}
+	}
+	for _, rule := range pack.Rules {
+		// Rules are referenced by name whatever their type
+		if err := checkName("rule", rule.Name); err != nil {
+			return err
+		}
+		if _, err := decodeCSEContentPackRule(rule); err != nil {
+			return fmt.Errorf("invalid rule %s of content pack %s: %v", rule.Name, pack.Name, err)
+		}
+	}
+	// The rules are referenced by the name of a rule of the pack or by the id of another rule
+	checkRuleIds := func(kind string, name string, ruleIds []string) error {
+		for _, ruleId := range ruleIds {
+			if !names["rule"+cseContentPackObjectKeySeparator+ruleId] && !cseRuleIdRegex.MatchString(ruleId) {
+				return fmt.Errorf("%s %s of content pack %s references %q, which is neither a rule of the pack nor a rule id",
+					strings.ReplaceAll(kind, "_", " "), name, pack.Name, ruleId)
+			}
+		}
+		return nil
+	}
+
+	for _, ruleTuningExpression := range pack.RuleTuningExpressions {
+		if err := checkName(cseContentPackRuleTuningExpression, ruleTuningExpression.Name); err != nil {
+			return err
+		}
+		if err := checkRuleIds(cseContentPackRuleTuningExpression, ruleTuningExpression.Name, ruleTuningExpression.RuleIds); err != nil {
+			return err
+		}
+	}
+	for _, customInsight := range pack.CustomInsights {
+		if err := checkName(cseContentPackCustomInsight, customInsight.Name); err != nil {
+			return err
+		}
+		if err := checkRuleIds(cseContentPackCustomInsight, customInsight.Name, customInsight.RuleIds); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeCSEContentPackRule decodes the fields of a rule into the rule type of its type.
+func decodeCSEContentPackRule(rule CSEContentPackRule) (interface{}, error) {
+	ruleType, ok := cseContentPackRuleTypes[rule.Type]
+	if !ok {
+		return nil, fmt.Errorf("unknown rule type %q, expected one of %s", rule.Type, strings.Join(cseRuleTypes, ", "))
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(rule.Fields, &fields); err != nil {
+		return nil, err
+	}
+	delete(fields, "type")
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	return ruleType.decode(data)
+}
+
+// cseContentPackObject is an object of a content pack, applied with the ids of the rules of the pack.
+type cseContentPackObject struct {
+	key string
+	// definition is the object as defined in the pack, to apply only the objects that changed.
+	definition string
+	// ruleName is the name of the object if it's a rule.
+	ruleName string
+	apply    func(c *Client, id string, ruleIds map[string]string) (string, error)
+}
+
+func cseContentPackObjectKey(kind string, name string) string {
+	return kind + cseContentPackObjectKeySeparator + name
+}
+
+func splitCSEContentPackObjectKey(key string) (string, string) {
+	parts := strings.SplitN(key, cseContentPackObjectKeySeparator, 2)
+	if len(parts) < 2 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+// cseContentPackKindRank orders the kinds of objects so that objects are applied after the objects
+// they depend on.
+func cseContentPackKindRank(kind string) int {
+	switch {
+	case kind == cseContentPackMatchList:
+		return 0
+	case strings.HasSuffix(kind, cseContentPackRuleKindSuffix):
+		return 1
+	case kind == cseContentPackRuleTuningExpression:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// sortCSEContentPackObjectKeys sorts keys in dependency order, or in reverse dependency order to delete them.
+func sortCSEContentPackObjectKeys(keys []string, reverse bool) {
+	sort.SliceStable(keys, func(i, j int) bool {
+		kindI, nameI := splitCSEContentPackObjectKey(keys[i])
+		kindJ, nameJ := splitCSEContentPackObjectKey(keys[j])
+		rankI, rankJ := cseContentPackKindRank(kindI), cseContentPackKindRank(kindJ)
+		if rankI != rankJ {
+			return (rankI < rankJ) != reverse
+		}
+		return kindI+nameI < kindJ+nameJ
+	})
+}
+
+// resolveCSEContentPackRuleIds replaces the names of the rules of the pack by their ids. Other values
+// are kept as they are, as the ids of rules outside of the pack.
+func resolveCSEContentPackRuleIds(ruleNames []string, ruleIds map[string]string) []string {
+	resolved := make([]string, len(ruleNames))
+	for i, name := range ruleNames {
+		if id, ok := ruleIds[name]; ok {
+			resolved[i] = id
+		} else {
+			resolved[i] = name
+		}
+	}
+	return resolved
+}
+
+// cseContentPackObjects returns the objects of the pack in dependency order.
+func cseContentPackObjects(pack CSEContentPack) []cseContentPackObject {
+	var objects []cseContentPackObject
+	definition := func(v interface{}) string {
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+
+	for _, matchList := range pack.MatchLists {
+		matchList := matchList
+		objects = append(objects, cseContentPackObject{
+			key:        cseContentPackObjectKey(cseContentPackMatchList, matchList.Name),
+			definition: definition(matchList),
+			apply: func(c *Client, id string, _ map[string]string) (string, error) {
+				return applyCSEContentPackMatchList(c, id, matchList)
+			},
+		})
+	}
+
+	for _, rule := range pack.Rules {
+		rule := rule
+		ruleType := cseContentPackRuleTypes[rule.Type]
+		objects = append(objects, cseContentPackObject{
+			key:        cseContentPackObjectKey(rule.Type+cseContentPackRuleKindSuffix, rule.Name),
+			definition: definition(rule),
+			ruleName:   rule.Name,
+			apply: func(c *Client, id string, _ map[string]string) (string, error) {
+				decoded, err := decodeCSEContentPackRule(rule)
+				if err != nil {
+					return "", err
+				}
+				if id == "" {
+					return ruleType.create(c, decoded)
+				}
+				return id, ruleType.update(c, id, decoded)
+			},
+		})
+	}
+
+	for _, ruleTuningExpression := range pack.RuleTuningExpressions {
+		ruleTuningExpression := ruleTuningExpression
+		objects = append(objects, cseContentPackObject{
+			key:        cseContentPackObjectKey(cseContentPackRuleTuningExpression, ruleTuningExpression.Name),
+			definition: definition(ruleTuningExpression),
+			apply: func(c *Client, id string, ruleIds map[string]string) (string, error) {
+				resolved := ruleTuningExpression
+				resolved.RuleIds = resolveCSEContentPackRuleIds(ruleTuningExpression.RuleIds, ruleIds)
+				if id == "" {
+					return c.CreateCSERuleTuningExpression(resolved)
+				}
+				resolved.ID = id
+				return id, c.UpdateCSERuleTuningExpression(resolved)
+			},
+		})
+	}
+
+	for _, customInsight := range pack.CustomInsights {
+		customInsight := customInsight
+		objects = append(objects, cseContentPackObject{
+			key:        cseContentPackObjectKey(cseContentPackCustomInsight, customInsight.Name),
+			definition: definition(customInsight),
+			apply: func(c *Client, id string, ruleIds map[string]string) (string, error) {
+				resolved := customInsight
+				resolved.RuleIds = resolveCSEContentPackRuleIds(customInsight.RuleIds, ruleIds)
+				if id == "" {
+					return c.CreateCSECustomInsight(resolved)
+				}
+				resolved.ID = id
+				return id, c.UpdateCSECustomInsight(resolved)
+			},
+		})
+	}
+
+	return objects
+}
+
+// applyCSEContentPackMatchList creates or updates a match list and makes its items the items of the pack.
+func applyCSEContentPackMatchList(c *Client, id string, matchList CSEContentPackMatchList) (string, error) {
+	var existingItems []CSEMatchListItemGet
+	if id == "" {
+		var err error
+		id, err = c.CreateCSEMatchList(matchList.CSEMatchListPost)
+		if err != nil {
+			return "", err
+		}
+	} else {
+		update := matchList.CSEMatchListPost
+		update.ID = id
+		if err := c.UpdateCSEMatchList(update); err != nil {
+			return id, err
+		}
+		var err error
+		existingItems, err = getCSEMatchListItemsAll(c, id)
+		if err != nil {
+			return id, err
+		}
+	}
+
+	// The match list belongs to the pack, so items that aren't in the pack are deleted
+	addItems, deleteItemIds := diffCSEMatchListItems(existingItems, matchList.Items,
+		schema.NewSet(schema.HashString, nil), true)
+	if len(deleteItemIds) > 0 {
+		if err := c.DeleteCSEMatchListItems(deleteItemIds, id); err != nil {
+			return id, err
+		}
+	}
+	if len(addItems) > 0 {
+		if err := c.CreateCSEMatchListItems(addItems, id); err != nil {
+			return id, err
+		}
+	}
+	return id, nil
+}
+
+func deleteCSEContentPackObject(c *Client, key string, id string) error {
+	kind, _ := splitCSEContentPackObjectKey(key)
+	switch kind {
+	case cseContentPackMatchList:
+		return c.DeleteCSEMatchList(id)
+	case cseContentPackRuleTuningExpression:
+		return c.DeleteCSERuleTuningExpression(id)
+	case cseContentPackCustomInsight:
+		return c.DeleteCSECustomInsight(id)
+	}
+	ruleType, ok := cseContentPackRuleTypes[strings.TrimSuffix(kind, cseContentPackRuleKindSuffix)]
+	if !ok {
+		return fmt.Errorf("unknown kind of content pack object %s", key)
+	}
+	return ruleType.delete(c, id)
+}
+
+// cseContentPackRuleIds returns the ids of the rules of a pack by name, from the ids of its objects.
+func cseContentPackRuleIds(objectIds map[string]string) map[string]string {
+	ruleIds := make(map[string]string)
+	for key, id := range objectIds {
+		kind, name := splitCSEContentPackObjectKey(key)
+		if strings.HasSuffix(kind, cseContentPackRuleKindSuffix) {
+			ruleIds[name] = id
+		}
+	}
+	return ruleIds
+}
+
+// ApplyCSEContentPack applies a content pack in dependency order: match lists, rules, rule tuning
+// expressions and custom insights, then deletes the objects that are no longer in the pack.
+//
+// objectIds are the ids of the objects of the pack applied so far, by object key, and previous is the
+// pack applied so far, if any. Only the objects that changed since previous, or whose key is in drifted
+// because they were changed outside of the pack, are applied. When an object fails to be applied, the
+// objects created so far are deleted and the objects updated so far are restored as they are in
+// previous. The ids of the objects of the pack are returned, also on failure.
+func (s *Client) ApplyCSEContentPack(pack CSEContentPack, previous *CSEContentPack, objectIds map[string]string,
+	drifted map[string]bool) (map[string]string, error) {
+	ids := make(map[string]string, len(objectIds))
+	for key, id := range objectIds {
+		ids[key] = id
+	}
+	previousRuleIds := cseContentPackRuleIds(objectIds)
+	ruleIds := cseContentPackRuleIds(objectIds)
+
+	previousObjects := make(map[string]cseContentPackObject)
+	if previous != nil {
+		for _, object := range cseContentPackObjects(*previous) {
+			previousObjects[object.key] = object
+		}
+	}
+
+	type appliedObject struct {
+		key     string
+		created bool
+	}
+	var applied []appliedObject
+	keys := make(map[string]bool)
+
+	for _, object := range cseContentPackObjects(pack) {
+		keys[object.key] = true
+		id := ids[object.key]
+		if previousObject, ok := previousObjects[object.key]; ok && id != "" &&
+			previousObject.definition == object.definition && !drifted[object.key] {
+			continue
+		}
+
+		newId, err := object.apply(s, id, ruleIds)
+		if newId != "" {
+			ids[object.key] = newId
+		}
+		if newId != "" && id == "" {
+			applied = append(applied, appliedObject{key: object.key, created: true})
+		} else if id != "" {
+			applied = append(applied, appliedObject{key: object.key})
+		}
+		if err != nil {
+			err = fmt.Errorf("error applying %s of CSE content pack %s: %v", object.key, pack.Name, err)
+
+			// Roll back in reverse order, so that objects are deleted before the objects they depend on
+			var rollbackErrors []string
+			for i := len(applied) - 1; i >= 0; i-- {
+				key := applied[i].key
+				if applied[i].created {
+					if deleteErr := deleteCSEContentPackObject(s, key, ids[key]); deleteErr != nil {
+						rollbackErrors = append(rollbackErrors, fmt.Sprintf("deleting %s: %v", key, deleteErr))
+					} else {
+						delete(ids, key)
+					}
+				} else if previousObject, ok := previousObjects[key]; ok {
+					if _, restoreErr := previousObject.apply(s, ids[key], previousRuleIds); restoreErr != nil {
+						rollbackErrors = append(rollbackErrors, fmt.Sprintf("restoring %s: %v", key, restoreErr))
+					}
+				}
+			}
+			if len(rollbackErrors) > 0 {
+				err = fmt.Errorf("%v; rolling back failed: %s", err, strings.Join(rollbackErrors, "; "))
+			}
+			return ids, err
+		}
+		if object.ruleName != "" {
+			ruleIds[object.ruleName] = newId
+		}
+	}
+
+	var removedKeys []string
+	for key := range ids {
+		if !keys[key] {
+			removedKeys = append(removedKeys, key)
+		}
+	}
+	sortCSEContentPackObjectKeys(removedKeys, true)
+	for _, key := range removedKeys {
+		if err := deleteCSEContentPackObject(s, key, ids[key]); err != nil {
+			return ids, fmt.Errorf("error deleting %s of CSE content pack %s: %v", key, pack.Name, err)
+		}
+		delete(ids, key)
+	}
+
+	return ids, nil
+}
+
+// DeleteCSEContentPack deletes the objects of a content pack in reverse dependency order. The ids of the
+// objects that are left are returned, also on failure.
+func (s *Client) DeleteCSEContentPack(objectIds map[string]string) (map[string]string, error) {
+	ids := make(map[string]string, len(objectIds))
+	keys := make([]string, 0, len(objectIds))
+	for key, id := range objectIds {
+		ids[key] = id
+		keys = append(keys, key)
+	}
+	sortCSEContentPackObjectKeys(keys, true)
+
+	for _, key := range keys {
+		if err := deleteCSEContentPackObject(s, key, ids[key]); err != nil {
+			return ids, fmt.Errorf("error deleting %s of CSE content pack: %v", key, err)
+		}
+		delete(ids, key)
+	}
+	return ids, nil
+}
+
+// ExportCSEContentPack reads the objects of a content pack back as a content pack, with the rules of the
+// pack referenced by name. The ids of the objects that still exist are returned with it.
+func (s *Client) ExportCSEContentPack(name string, version string, objectIds map[string]string) (*CSEContentPack, map[string]string, error) {
+	keys := make([]string, 0, len(objectIds))
+	for key := range objectIds {
+		keys = append(keys, key)
+	}
+	sortCSEContentPackObjectKeys(keys, false)
+
+	ruleNames := make(map[string]string)
+	for name, id := range cseContentPackRuleIds(objectIds) {
+		ruleNames[id] = name
+	}
+	namedRules := func(ruleIds []string) []string {
+		return resolveCSEContentPackRuleIds(ruleIds, ruleNames)
+	}
+
+	pack := CSEContentPack{Name: name, Version: version}
+	ids := make(map[string]string)
+	for _, key := range keys {
+		id := objectIds[key]
+		kind, _ := splitCSEContentPackObjectKey(key)
+
+		switch kind {
+		case cseContentPackMatchList:
+			matchList, err := s.GetCSEMatchList(id, true)
+			if err != nil {
+				return nil, nil, err
+			}
+			if matchList == nil {
+				continue
+			}
+			existingItems, err := getCSEMatchListItemsAll(s, id)
+			if err != nil {
+				return nil, nil, err
+			}
+			items := make([]CSEMatchListItemPost, len(existingItems))
+			for i, item := range existingItems {
+				items[i] = CSEMatchListItemPost{
+					Active:      item.Active,
+					Description: item.Meta.Description,
+					Expiration:  item.Expiration,
+					Value:       item.Value,
+				}
+			}
+			pack.MatchLists = append(pack.MatchLists, CSEContentPackMatchList{
+				CSEMatchListPost: CSEMatchListPost{
+					Active:       matchList.Active,
+					DefaultTtl:   matchList.DefaultTtl,
+					Description:  matchList.Description,
+					Name:         matchList.Name,
+					TargetColumn: matchList.TargetColumn,
+				},
+				Items: items,
+			})
+
+		case cseContentPackRuleTuningExpression:
+			ruleTuningExpression, err := s.GetCSERuleTuningExpression(id)
+			if err != nil {
+				return nil, nil, err
+			}
+			if ruleTuningExpression == nil {
+				continue
+			}
+			ruleTuningExpression.ID = ""
+			ruleTuningExpression.RuleIds = namedRules(ruleTuningExpression.RuleIds)
+			pack.RuleTuningExpressions = append(pack.RuleTuningExpressions, *ruleTuningExpression)
+
+		case cseContentPackCustomInsight:
+			customInsight, err := s.GetCSECustomInsight(id)
+			if err != nil {
+				return nil, nil, err
+			}
+			if customInsight == nil {
+				continue
+			}
+			customInsight.ID = ""
+			customInsight.RuleIds = namedRules(customInsight.RuleIds)
+			pack.CustomInsights = append(pack.CustomInsights, *customInsight)
+
+		default:
+			rule, err := exportCSEContentPackRule(s, kind, id)
+			if err != nil {
+				return nil, nil, err
+			}
+			if rule == nil {
+				continue
+			}
+			pack.Rules = append(pack.Rules, *rule)
+		}
+		ids[key] = id
+	}
+
+	return &pack, ids, nil
+}
+
+// cseContentPackDriftedKeys returns the keys of the objects of the pack that exist but whose exported
+// definition differs from their definition in the pack. Only the fields of the pack that are exported are
+// compared, since the API also returns fields that the pack doesn't set and may not return some of them.
+func cseContentPackDriftedKeys(pack CSEContentPack, exported CSEContentPack, existingIds map[string]string) []string {
+	exportedDefinitions := cseContentPackDefinitions(exported)
+
+	var drifted []string
+	for key, definition := range cseContentPackDefinitions(pack) {
+		if _, ok := existingIds[key]; !ok {
+			continue
+		}
+		// An object that isn't exported under its key was renamed
+		exportedDefinition, ok := exportedDefinitions[key]
+		if !ok {
+			drifted = append(drifted, key)
+			continue
+		}
+
+		var value, exportedValue interface{}
+		if json.Unmarshal([]byte(definition), &value) != nil || json.Unmarshal([]byte(exportedDefinition), &exportedValue) != nil {
+			continue
+		}
+		if !cseContentPackValueMatches(value, exportedValue) {
+			drifted = append(drifted, key)
+		}
+	}
+	sortCSEContentPackObjectKeys(drifted, false)
+	return drifted
+}
+
+// cseContentPackValueMatches returns whether an exported JSON value has the fields of a value of the
+// pack. Lists must have the same elements in any order, such as the items of a match list.
+func cseContentPackValueMatches(value interface{}, exported interface{}) bool {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		exported, ok := exported.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, fieldValue := range value {
+			if exportedFieldValue, ok := exported[key]; ok && !cseContentPackValueMatches(fieldValue, exportedFieldValue) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		exported, ok := exported.([]interface{})
+		if !ok || len(exported) != len(value) {
+			return false
+		}
+		matched := make([]bool, len(exported))
+		for _, element := range value {
+			found := false
+			for i, exportedElement := range exported {
+				if !matched[i] && cseContentPackValueMatches(element, exportedElement) {
+					matched[i] = true
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(value, exported)
+	}
+}
+
+func exportCSEContentPackRule(c *Client, kind string, id string) (*CSEContentPackRule, error) {
+	typeName := strings.TrimSuffix(kind, cseContentPackRuleKindSuffix)
+	ruleType, ok := cseContentPackRuleTypes[typeName]
+	if !ok {
+		return nil, fmt.Errorf("unknown kind of content pack object %s", kind)
+	}
+	rule, err := ruleType.get(c, id)
+	if err != nil || rule == nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(rule)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	delete(fields, "id")
+	fields["type"] = typeName
+	// Rules are read with the window size in milliseconds and its name in windowSizeName, while they are
+	// written, and defined in packs, with the name in windowSize.
+	if windowSizeName, ok := fields["windowSizeName"]; ok {
+		fields["windowSize"] = windowSizeName
+		delete(fields, "windowSizeName")
+	}
+	data, err = json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var exported CSEContentPackRule
+	err = json.Unmarshal(data, &exported)
+	return &exported, err
+}
+
+// FormatCSEContentPack formats a content pack as a JSON or YAML document.
+func FormatCSEContentPack(pack CSEContentPack, format string) (string, error) {
+	data, err := json.MarshalIndent(pack, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	if format != "yaml" {
+		return string(data), nil
+	}
+
+	var document interface{}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return "", err
+	}
+	yamlData, err := yaml.Marshal(document)
+	if err != nil {
+		return "", err
+	}
+	return string(yamlData), nil
+}
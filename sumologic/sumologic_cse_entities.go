@@ -0,0 +1,179 @@
+package sumologic
+
+import (
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// CSEEntitySearch selects the entities returned by SearchCSEEntities.
+type CSEEntitySearch struct {
+	Query      string
+	EntityType string
+	NameRegex  *regexp.Regexp
+	// Prefix, Suffix, NetworkBlock and Namespace select entities like the expression of an entity group.
+	Prefix       string
+	Suffix       string
+	NetworkBlock *net.IPNet
+	Namespace    string
+	// Tags selects the entities that have all of them.
+	Tags        []string
+	Criticality string
+}
+
+// query returns the query selecting the entities, which are also matched by matches, since the name
+// regex and the network block can't be sent.
+func (f CSEEntitySearch) query() cseQuery {
+	query := newCSEQuery(f.Query)
+	if f.EntityType != "" {
+		query = query.field("entityType", f.EntityType)
+	}
+	if f.Prefix != "" {
+		query = query.field("name", f.Prefix+"*")
+	}
+	if f.Suffix != "" {
+		query = query.field("name", "*"+f.Suffix)
+	}
+	if f.Namespace != "" {
+		query = query.field("namespace", f.Namespace)
+	}
+	for _, tag := range f.Tags {
+		query = query.field("tags", tag)
+	}
+	if f.Criticality != "" {
+		query = query.field("criticality", f.Criticality)
+	}
+	return query
+}
+
+func (f CSEEntitySearch) matches(entity CSEEntity) bool {
+	name := strings.ToLower(entity.Name)
+	if f.EntityType != "" && f.EntityType != entity.EntityType {
+		return false
+	}
+	if f.NameRegex != nil && !f.NameRegex.MatchString(entity.Name) {
+		return false
+	}
+	if f.Prefix != "" && !strings.HasPrefix(name, strings.ToLower(f.Prefix)) {
+		return false
+	}
+	if f.Suffix != "" && !strings.HasSuffix(name, strings.ToLower(f.Suffix)) {
+		return false
+	}
+	if f.NetworkBlock != nil {
+		ip := net.ParseIP(entity.Name)
+		if ip == nil || !f.NetworkBlock.Contains(ip) {
+			return false
+		}
+	}
+	if f.Namespace != "" && f.Namespace != entity.Namespace {
+		return false
+	}
+	for _, tag := range f.Tags {
+		if !contains(entity.Tags, tag) {
+			return false
+		}
+	}
+	if f.Criticality != "" && f.Criticality != entity.Criticality {
+		return false
+	}
+	return true
+}
+
+// SearchCSEEntities lists the entities that match the search.
+func (s *Client) SearchCSEEntities(search CSEEntitySearch) ([]CSEEntity, error) {
+	return searchCSEObjects(s, "sec/v1/entities", "entities", search.query().params(), search.matches)
+}
+
+// CountCSEEntities returns the number of entities that match the search. Unless the search has filters
+// that are only matched on the client, the entities are counted by the API instead of being listed.
+func (s *Client) CountCSEEntities(search CSEEntitySearch) (int, error) {
+	if search.NameRegex == nil && search.NetworkBlock == nil {
+		return countCSEObjects(s, "sec/v1/entities", "entities", search.query().params())
+	}
+	entities, err := s.SearchCSEEntities(search)
+	return len(entities), err
+}
+
+// CSEInventorySearch selects the inventory returned by SearchCSEInventory.
+type CSEInventorySearch struct {
+	InventoryType string
+	Source        string
+	NameRegex     *regexp.Regexp
+	// Key and Value select the inventory whose field Key has the value Value, or has it among its
+	// values, ignoring case. Any value is selected when Value is empty.
+	Key   string
+	Value string
+}
+
+func (f CSEInventorySearch) matches(inventory CSEInventory) bool {
+	if f.NameRegex != nil && !f.NameRegex.MatchString(inventory.Name) {
+		return false
+	}
+	if f.Key == "" {
+		return true
+	}
+
+	fieldValue, ok := inventory.Fields[f.Key]
+	if !ok || fieldValue == nil {
+		return false
+	}
+	if f.Value == "" {
+		return true
+	}
+	if values, ok := fieldValue.([]interface{}); ok {
+		for _, value := range values {
+			if strings.EqualFold(formatCSERecordValue(value), f.Value) {
+				return true
+			}
+		}
+		return false
+	}
+	return strings.EqualFold(formatCSERecordValue(fieldValue), f.Value)
+}
+
+func (f CSEInventorySearch) params() url.Values {
+	params := url.Values{}
+	if f.InventoryType != "" {
+		params.Set("inventoryType", f.InventoryType)
+	}
+	if f.Source != "" {
+		params.Set("source", f.Source)
+	}
+	return params
+}
+
+// SearchCSEInventory lists the inventory that matches the search. The API only supports selecting the
+// inventory by type and source, so the name and fields are matched on the inventory it returns.
+func (s *Client) SearchCSEInventory(search CSEInventorySearch) ([]CSEInventory, error) {
+	return searchCSEObjects(s, "sec/v1/inventory", "inventory", search.params(), search.matches)
+}
+
+// CountCSEInventory returns the number of inventory that matches the search, counted by the API unless
+// the search selects the inventory by name or field.
+func (s *Client) CountCSEInventory(search CSEInventorySearch) (int, error) {
+	if search.NameRegex == nil && search.Key == "" {
+		return countCSEObjects(s, "sec/v1/inventory", "inventory", search.params())
+	}
+	inventory, err := s.SearchCSEInventory(search)
+	return len(inventory), err
+}
+
+type CSEEntity struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	EntityType  string   `json:"entityType"`
+	Namespace   string   `json:"namespace"`
+	Criticality string   `json:"criticality"`
+	Tags        []string `json:"tags"`
+	Suppressed  bool     `json:"isSuppressed"`
+}
+
+type CSEInventory struct {
+	ID            string                 `json:"id"`
+	Name          string                 `json:"name"`
+	InventoryType string                 `json:"inventoryType"`
+	Source        string                 `json:"source"`
+	Fields        map[string]interface{} `json:"fields"`
+}
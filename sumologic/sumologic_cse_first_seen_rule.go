@@ -124,6 +124,7 @@ type CSEFirstSeenRule struct {
 	ValueFields           []string         `json:"valueFields"`
 	Version               int              `json:"version"`
 	SuppressionWindowSize *int             `json:"suppressionWindowSize,omitempty"`
+	RuleSource            string           `json:"ruleSource,omitempty"`
 }
 
 type CSEFirstSeenRuleOverride struct {
@@ -0,0 +1,99 @@
+package sumologic
+
+import (
+	"strings"
+	"time"
+)
+
+// CSEInsightSearch selects the insights returned by SearchCSEInsights.
+type CSEInsightSearch struct {
+	Query string
+	// From and To select the insights created in [From, To). Zero values leave the range open.
+	From time.Time
+	To   time.Time
+	// Statuses and Severities select the insights with any of them.
+	Statuses    []string
+	Severities  []string
+	EntityType  string
+	EntityValue string
+	// RuleIDs selects the insights with a signal generated by any of the rules.
+	RuleIDs []string
+	// Tags selects the insights that have all of them.
+	Tags []string
+}
+
+func (f CSEInsightSearch) matches(insight CSEInsight) bool {
+	if !cseTimestampInRange(insight.Created, f.From, f.To) {
+		return false
+	}
+	if len(f.Statuses) > 0 && !containsFold(f.Statuses, insight.Status.Name) {
+		return false
+	}
+	if len(f.Severities) > 0 && !containsFold(f.Severities, insight.Severity) {
+		return false
+	}
+	if f.EntityType != "" && f.EntityType != insight.Entity.EntityType {
+		return false
+	}
+	if f.EntityValue != "" && !strings.EqualFold(f.EntityValue, insight.Entity.Value) {
+		return false
+	}
+	if len(f.RuleIDs) > 0 {
+		matched := false
+		for _, signal := range insight.Signals {
+			if contains(f.RuleIDs, signal.RuleID) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	for _, tag := range f.Tags {
+		if !contains(insight.Tags, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsFold(slice []string, item string) bool {
+	for _, s := range slice {
+		if strings.EqualFold(s, item) {
+			return true
+		}
+	}
+	return false
+}
+
+// SearchCSEInsights lists the insights that match the search. Their creation time range is sent in the
+// query of the API.
+func (s *Client) SearchCSEInsights(search CSEInsightSearch) ([]CSEInsight, error) {
+	query := newCSEQuery(search.Query).timeRange("created", search.From, search.To)
+	return searchCSEObjects(s, "sec/v1/insights", "insights", query.params(), search.matches)
+}
+
+type CSEInsight struct {
+	ID         string              `json:"id"`
+	ReadableID string              `json:"readableId"`
+	Name       string              `json:"name"`
+	Created    string              `json:"created"`
+	Severity   string              `json:"severity"`
+	Status     CSEInsightStatus    `json:"status"`
+	Resolution string              `json:"resolution"`
+	Assignee   *CSEInsightAssignee `json:"assignee"`
+	Entity     CSESignalEntity     `json:"entity"`
+	Tags       []string            `json:"tags"`
+	Signals    []CSESignal         `json:"signals"`
+}
+
+type CSEInsightStatus struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+type CSEInsightAssignee struct {
+	AssigneeType string `json:"assigneeType"`
+	Username     string `json:"username"`
+}
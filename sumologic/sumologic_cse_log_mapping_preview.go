@@ -0,0 +1,59 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// PreviewCSELogMapping runs the raw logs through the log mapping test endpoint and returns the result
+// of the mapping of each of them.
+func (s *Client) PreviewCSELogMapping(logMapping CSELogMapping, logs []string) ([]CSELogMappingPreviewResult, error) {
+	logMapping.ID = ""
+	request := CSELogMappingPreviewRequest{
+		CSELogMappingPreview: CSELogMappingPreview{
+			LogMapping: logMapping,
+			Logs:       logs,
+		},
+	}
+
+	responseBody, err := s.Post("sec/v1/log-mappings/test", request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response CSELogMappingPreviewResponse
+	err = json.Unmarshal(responseBody, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	results := response.CSELogMappingPreviewResults.Results
+	if len(results) != len(logs) {
+		return nil, fmt.Errorf("expected a result for each of the %d logs, got %d", len(logs), len(results))
+	}
+	return results, nil
+}
+
+type CSELogMappingPreviewRequest struct {
+	CSELogMappingPreview CSELogMappingPreview `json:"fields"`
+}
+
+type CSELogMappingPreview struct {
+	LogMapping CSELogMapping `json:"logMapping"`
+	Logs       []string      `json:"logs"`
+}
+
+type CSELogMappingPreviewResponse struct {
+	CSELogMappingPreviewResults CSELogMappingPreviewResults `json:"data"`
+}
+
+type CSELogMappingPreviewResults struct {
+	Results []CSELogMappingPreviewResult `json:"results"`
+}
+
+// CSELogMappingPreviewResult holds the normalized records a raw log is mapped to, none when the log
+// is dropped by the mapping, and the errors of the mapping.
+type CSELogMappingPreviewResult struct {
+	Records []map[string]interface{} `json:"records"`
+	Errors  []string                 `json:"errors"`
+}
@@ -93,7 +93,7 @@ func (s *Client) GetCSEMatchListItemsInMatchList(matchListId string) (*CSEMatchL
 
 func (s *Client) GetCSEMatchListItemsAllInMatchList(matchListId string) (*CSEMatchListItemsAllInMatchListGet, error) {
 	response, err := s.SendGetCSEMatchListItemsAllRequest(matchListId, "")
-	if err != nil {
+	if err != nil || response == nil {
 		return nil, err
 	}
 
@@ -139,21 +139,25 @@ func (s *Client) SendCreateCSEMatchListItemsRequest(cseMatchListItemPost []CSEMa
 }
 
 func (s *Client) CreateCSEMatchListItems(cseMatchListItemPost []CSEMatchListItemPost, matchListID string) error {
-	var start = 0
-	var end = 1000
+	return sendCSEBatches(cseMatchListItemPost, func(batch []CSEMatchListItemPost) error {
+		return s.SendCreateCSEMatchListItemsRequest(batch, matchListID)
+	})
+}
 
-	//If there are more than 1000 items, send requests in batches of 1000
-	for end < len(cseMatchListItemPost) {
-		err := s.SendCreateCSEMatchListItemsRequest(cseMatchListItemPost[start:end], matchListID)
-		if err != nil {
-			return err
-		}
-		start += 1000
-		end += 1000
+func (s *Client) SendDeleteCSEMatchListItemsRequest(ids []string, matchListID string) error {
+	request := CSEMatchListItemRequestDelete{
+		IDs: ids,
 	}
 
-	return s.SendCreateCSEMatchListItemsRequest(cseMatchListItemPost[start:], matchListID)
+	_, err := s.Post(fmt.Sprintf("sec/v1/match-lists/%s/items/bulk-delete", matchListID), request)
 
+	return err
+}
+
+func (s *Client) DeleteCSEMatchListItems(ids []string, matchListID string) error {
+	return sendCSEBatches(ids, func(batch []string) error {
+		return s.SendDeleteCSEMatchListItemsRequest(batch, matchListID)
+	})
 }
 
 func (s *Client) UpdateCSEMatchListItem(cseMatchListItemPost CSEMatchListItemPost) error {
@@ -176,6 +180,10 @@ type CSEMatchListItemRequestPost struct {
 	CSEMatchListItemPost []CSEMatchListItemPost `json:"items"`
 }
 
+type CSEMatchListItemRequestDelete struct {
+	IDs []string `json:"ids"`
+}
+
 type CSEMatchListItemRequestUpdate struct {
 	CSEMatchListItemUpdate CSEMatchListItemUpdate `json:"fields"`
 }
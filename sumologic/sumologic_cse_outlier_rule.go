@@ -131,6 +131,7 @@ type CSEOutlierRule struct {
 	WindowSize            windowSizeField       `json:"windowSize,omitempty"`
 	WindowSizeName        string                `json:"windowSizeName,omitempty"`
 	SuppressionWindowSize *int                  `json:"suppressionWindowSize,omitempty"`
+	RuleSource            string                `json:"ruleSource,omitempty"`
 }
 
 type CSEOutlierRuleOverride struct {
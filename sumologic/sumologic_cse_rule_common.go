@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func getEntitySelectorsSchema() *schema.Schema {
@@ -28,6 +29,15 @@ func getEntitySelectorsSchema() *schema.Schema {
 	}
 }
 
+// getOptionalEntitySelectorsSchema returns the schema of the entity selectors of rules that can't override
+// them, which are only required by validateRuleRequiredFields when a rule is created.
+func getOptionalEntitySelectorsSchema() *schema.Schema {
+	entitySelectors := getEntitySelectorsSchema()
+	entitySelectors.Required = false
+	entitySelectors.Optional = true
+	return entitySelectors
+}
+
 func getSeverityMappingSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -203,6 +213,155 @@ func (s *Client) GetCSERuleSource(id string) (string, error) {
 	return response.CSERuleSource.RuleSource, nil
 }
 
+// Built-in rules are shipped by Sumo Logic and can't be created or deleted. A rule resource adopts a
+// built-in rule by its rule_id instead, and manages only its overridable fields and whether it is enabled.
+// The other fields keep their configured values once the rule is in the state. When the resource is
+// destroyed, the overridable fields and the enabled state the rule had when it was adopted are restored.
+
+func isBuiltInRule(ruleSource string) bool {
+	return ruleSource != "" && ruleSource != "user"
+}
+
+func getBuiltInRuleIdSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+}
+
+func getRuleSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+}
+
+func getBuiltInRuleDefaultsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+}
+
+// validateRuleRequiredFields returns an error if any of the given fields isn't set when a rule is created.
+// The fields that can't be overridden are optional in the schema, since a built-in rule that is adopted
+// with rule_id keeps their values, so they are only required when the resource doesn't set rule_id.
+func validateRuleRequiredFields(d *schema.ResourceDiff, ruleType string, fields []string,
+	nonOverridableFields []string) error {
+
+	if d.Id() != "" {
+		return nil
+	}
+	if _, ok := d.GetOk("rule_id"); !ok && d.NewValueKnown("rule_id") {
+		fields = append(fields, nonOverridableFields...)
+	}
+	for _, field := range fields {
+		if _, ok := d.GetOk(field); !ok && d.NewValueKnown(field) {
+			return fmt.Errorf("%q is required when creating a new %s rule", field, ruleType)
+		}
+	}
+	return nil
+}
+
+// readAllRuleFields returns whether all the fields of a rule are set in the state when it is read, rather
+// than only its overridable fields. They are, unless the rule is a built-in rule that is already in the
+// state, which is the case for a rule that was adopted or read before.
+func readAllRuleFields(d *schema.ResourceData, ruleSource string) bool {
+	return !isBuiltInRule(ruleSource) || d.Get("rule_source").(string) == ""
+}
+
+// CSEBuiltInRuleDefaults is the enabled state of a built-in rule when it was adopted, which is restored
+// when the resource that manages the rule is destroyed. Unlike its overridable fields, the enabled state
+// isn't part of the definition of the rule, so reverting the override doesn't restore it.
+type CSEBuiltInRuleDefaults struct {
+	Enabled bool `json:"enabled"`
+}
+
+// setBuiltInRuleDefaults records the enabled state of a built-in rule, unless it was recorded before.
+func setBuiltInRuleDefaults(d *schema.ResourceData, ruleSource string, enabled bool) error {
+	if !isBuiltInRule(ruleSource) || d.Get("built_in_defaults").(string) != "" {
+		return nil
+	}
+
+	defaults, err := json.Marshal(CSEBuiltInRuleDefaults{Enabled: enabled})
+	if err != nil {
+		return err
+	}
+	return d.Set("built_in_defaults", string(defaults))
+}
+
+// adoptBuiltInRule sets the id of the resource to the id of the built-in rule it manages, before its
+// overridable fields are overridden.
+func adoptBuiltInRule(d *schema.ResourceData, id string, ruleSource string, enabled bool) error {
+	if !isBuiltInRule(ruleSource) {
+		return fmt.Errorf("rule %s is not a built-in rule, import it instead", id)
+	}
+
+	err := setBuiltInRuleDefaults(d, ruleSource, enabled)
+	if err != nil {
+		return err
+	}
+	d.Set("rule_source", ruleSource)
+	d.SetId(id)
+	return nil
+}
+
+// updateBuiltInRuleEnabled enables or disables a built-in rule, since enabled isn't an overridable field.
+func updateBuiltInRuleEnabled(d *schema.ResourceData, c *Client) error {
+	if !d.IsNewResource() && !d.HasChange("enabled") {
+		return nil
+	}
+	return c.UpdateCSERuleEnabled(d.Id(), d.Get("enabled").(bool))
+}
+
+// restoreBuiltInRule reverts the override of a built-in rule, which restores the overridable fields of the
+// rule as shipped by Sumo Logic, and restores the enabled state recorded when it was adopted. ruleType is
+// the type of the rule in the override URL.
+func restoreBuiltInRule(d *schema.ResourceData, c *Client, ruleType string) error {
+	err := c.RevertCSERuleOverride(ruleType, d.Id())
+	if err != nil {
+		return err
+	}
+
+	tfDefaults := d.Get("built_in_defaults").(string)
+	if tfDefaults == "" {
+		log.Printf("[WARN] No enabled state recorded for built-in CSE rule %s, leaving it as is", d.Id())
+		return nil
+	}
+
+	var defaults CSEBuiltInRuleDefaults
+	err = json.Unmarshal([]byte(tfDefaults), &defaults)
+	if err != nil {
+		return err
+	}
+	return c.UpdateCSERuleEnabled(d.Id(), defaults.Enabled)
+}
+
+func (s *Client) UpdateCSERuleEnabled(id string, enabled bool) error {
+	url := fmt.Sprintf("sec/v1/rules/%s/enabled", id)
+
+	request := CSERuleEnabledRequest{
+		Enabled: enabled,
+	}
+	_, err := s.Put(url, request)
+
+	return err
+}
+
+func (s *Client) RevertCSERuleOverride(ruleType string, id string) error {
+	url := fmt.Sprintf("sec/v1/rules/%s/%s/override", ruleType, id)
+
+	_, err := s.Delete(url)
+
+	return err
+}
+
+type CSERuleEnabledRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
 type CSERuleSource struct {
 	RuleSource string `json:"ruleSource"`
 }
@@ -0,0 +1,109 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+var cseRuleTemplatePlaceholderRegex = regexp.MustCompile(`\{\{\s*([^{}\s]+)\s*\}\}`)
+
+// TestCSERule runs the records through the rule test endpoint and returns the result of each of them.
+func (s *Client) TestCSERule(test CSERuleTest) ([]CSERuleTestRecordResult, error) {
+	request := CSERuleTestRequest{
+		CSERuleTest: test,
+	}
+
+	responseBody, err := s.Post("sec/v1/rules/test", request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response CSERuleTestResponse
+	err = json.Unmarshal(responseBody, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	results := response.CSERuleTestResult.Results
+	if len(results) != len(test.Records) {
+		return nil, fmt.Errorf("expected a result for each of the %d records, got %d", len(test.Records), len(results))
+	}
+	return results, nil
+}
+
+// renderCSERuleTemplate replaces the {{field}} placeholders of a name or summary expression with the
+// values of the fields of the record, as they are rendered in the signals of the rule.
+func renderCSERuleTemplate(template string, record map[string]interface{}) string {
+	return cseRuleTemplatePlaceholderRegex.ReplaceAllStringFunc(template, func(placeholder string) string {
+		field := cseRuleTemplatePlaceholderRegex.FindStringSubmatch(placeholder)[1]
+		return formatCSERecordValue(record[field])
+	})
+}
+
+func formatCSERecordValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
+
+// severity returns the severity of the signal of a record, falling back to the default severity when
+// the field isn't populated or isn't mapped. It is only used when the rule test endpoint doesn't return
+// the severity, and only supports the eq mappings of a fieldValueMapping.
+func (m SeverityMapping) severity(record map[string]interface{}) (int, error) {
+	value := formatCSERecordValue(record[m.Field])
+	switch m.Type {
+	case "fieldValue":
+		if severity, err := strconv.Atoi(value); err == nil {
+			return severity, nil
+		}
+	case "fieldValueMapping":
+		for _, mapping := range m.Mapping {
+			if mapping.Type != "eq" {
+				return 0, fmt.Errorf("severity mappings of type %s can't be evaluated, only eq is supported", mapping.Type)
+			}
+			if mapping.From == value {
+				return mapping.To, nil
+			}
+		}
+	}
+	return m.Default, nil
+}
+
+type CSERuleTestRequest struct {
+	CSERuleTest CSERuleTest `json:"fields"`
+}
+
+type CSERuleTest struct {
+	Expression        string            `json:"expression"`
+	NameExpression    string            `json:"nameExpression,omitempty"`
+	SummaryExpression string            `json:"summaryExpression,omitempty"`
+	SeverityMapping   *SeverityMapping  `json:"severityMapping,omitempty"`
+	Records           []json.RawMessage `json:"records"`
+}
+
+type CSERuleTestResponse struct {
+	CSERuleTestResult CSERuleTestResult `json:"data"`
+}
+
+type CSERuleTestResult struct {
+	Results []CSERuleTestRecordResult `json:"results"`
+}
+
+// CSERuleTestRecordResult is the result of a record. The signal name, summary and severity are only
+// returned for records that match, by the versions of the API that render the signals of the rule.
+type CSERuleTestRecordResult struct {
+	Matched    bool    `json:"matched"`
+	SignalName *string `json:"signalName"`
+	Summary    *string `json:"summary"`
+	Severity   *int    `json:"severity"`
+}
@@ -0,0 +1,93 @@
+package sumologic
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// cseRuleTypes are the types of rules, as named by the rule resources.
+var cseRuleTypes = []string{"match", "threshold", "aggregation", "chain", "first_seen", "outlier"}
+
+var cseRuleTypeSeparatorRegex = regexp.MustCompile(`[\s_-]`)
+
+// CSERuleSearch selects the rules returned by SearchCSERules.
+type CSERuleSearch struct {
+	Query string
+	// Name selects the rules whose name contains it, ignoring case. It isn't sent to the API, whose
+	// field search only matches whole names.
+	Name string
+	// Tags selects the rules that have all of them.
+	Tags []string
+	// RuleTypes selects the rules of any of the types, as named by the rule resources. They aren't sent to
+	// the API, which names some of them differently depending on the rule.
+	RuleTypes []string
+	Enabled   *bool
+	// BuiltIn selects the built-in rules, whose source is anything but user, or the custom rules. Only
+	// the custom rules are selected in the query of the API, as it can't match any source but one.
+	BuiltIn *bool
+}
+
+// query returns the query of the API with the filters of the search it supports.
+func (f CSERuleSearch) query() cseQuery {
+	query := newCSEQuery(f.Query)
+	for _, tag := range f.Tags {
+		query = query.field("tags", tag)
+	}
+	if f.Enabled != nil {
+		query = append(query, "enabled:"+strconv.FormatBool(*f.Enabled))
+	}
+	if f.BuiltIn != nil && !*f.BuiltIn {
+		query = query.field("ruleSource", "user")
+	}
+	return query
+}
+
+func (f CSERuleSearch) matches(rule CSERuleSummary) bool {
+	if f.Name != "" && !strings.Contains(strings.ToLower(rule.Name), strings.ToLower(f.Name)) {
+		return false
+	}
+	for _, tag := range f.Tags {
+		if !contains(rule.Tags, tag) {
+			return false
+		}
+	}
+	if len(f.RuleTypes) > 0 && !contains(f.RuleTypes, rule.Type()) {
+		return false
+	}
+	if f.Enabled != nil && *f.Enabled != rule.Enabled {
+		return false
+	}
+	if f.BuiltIn != nil && *f.BuiltIn != isBuiltInRule(rule.RuleSource) {
+		return false
+	}
+	return true
+}
+
+// Type returns the type of the rule as named by the rule resources, such as first_seen for the
+// first-seen rules of the API and match for its templated match rules.
+func (r CSERuleSummary) Type() string {
+	ruleType := strings.TrimPrefix(strings.ToLower(r.RuleType), "templated")
+	ruleType = cseRuleTypeSeparatorRegex.ReplaceAllString(ruleType, "")
+	for _, t := range cseRuleTypes {
+		if ruleType == strings.ReplaceAll(t, "_", "") {
+			return t
+		}
+	}
+	return strings.ToLower(r.RuleType)
+}
+
+// SearchCSERules lists the rules that match the search. The filters the API supports are sent in its
+// query, and all of them are checked again on the rules it returns.
+func (s *Client) SearchCSERules(search CSERuleSearch) ([]CSERuleSummary, error) {
+	return searchCSEObjects(s, "sec/v1/rules", "rules", search.query().params(), search.matches)
+}
+
+type CSERuleSummary struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	RuleType   string   `json:"ruleType"`
+	RuleSource string   `json:"ruleSource"`
+	Enabled    bool     `json:"enabled"`
+	Tags       []string `json:"tags"`
+}
@@ -0,0 +1,151 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const cseSearchPageSize = 100
+
+type cseSearchResponse[T any] struct {
+	Page cseSearchPage[T] `json:"data"`
+}
+
+type cseSearchPage[T any] struct {
+	Objects []T `json:"objects"`
+	Total   int `json:"total"`
+}
+
+// searchCSEObjects lists the objects at path selected by params, going through all the pages of the
+// results, and returns the ones matches returns true for. name is the name of the objects in errors.
+func searchCSEObjects[T any](s *Client, path string, name string, params url.Values,
+	matches func(T) bool) ([]T, error) {
+
+	var objects []T
+	offset := 0
+	for {
+		page, err := getCSESearchPage[T](s, path, name, params, offset, cseSearchPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range page.Objects {
+			if matches(object) {
+				objects = append(objects, object)
+			}
+		}
+
+		offset += len(page.Objects)
+		if len(page.Objects) == 0 || offset >= page.Total {
+			return objects, nil
+		}
+	}
+}
+
+// countCSEObjects returns the number of objects at path selected by params, from the total of a search
+// for a single object, for searches whose filters are all sent in params.
+func countCSEObjects(s *Client, path string, name string, params url.Values) (int, error) {
+	page, err := getCSESearchPage[json.RawMessage](s, path, name, params, 0, 1)
+	if err != nil {
+		return 0, err
+	}
+	return page.Total, nil
+}
+
+func getCSESearchPage[T any](s *Client, path string, name string, params url.Values, offset int,
+	limit int) (*cseSearchPage[T], error) {
+
+	pageParams := url.Values{}
+	for key, values := range params {
+		pageParams[key] = values
+	}
+	pageParams.Set("limit", strconv.Itoa(limit))
+	pageParams.Set("offset", strconv.Itoa(offset))
+
+	data, err := s.Get(path + "?" + pageParams.Encode())
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, fmt.Errorf("%s not found", name)
+	}
+
+	var response cseSearchResponse[T]
+	err = json.Unmarshal(data, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response.Page, nil
+}
+
+// cseQuery builds the q parameter of a search from a free text query and field search terms.
+type cseQuery []string
+
+func newCSEQuery(query string) cseQuery {
+	if query == "" {
+		return cseQuery{}
+	}
+	return cseQuery{query}
+}
+
+// field adds a term that selects the objects whose field has the value.
+func (q cseQuery) field(field string, value string) cseQuery {
+	return append(q, fmt.Sprintf("%s:%s", field, strconv.Quote(value)))
+}
+
+// anyOf adds a term that selects the objects whose field has any of the values.
+func (q cseQuery) anyOf(field string, values []string) cseQuery {
+	switch len(values) {
+	case 0:
+		return q
+	case 1:
+		return q.field(field, values[0])
+	}
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = strconv.Quote(value)
+	}
+	return append(q, fmt.Sprintf("%s:[%s]", field, strings.Join(quoted, ",")))
+}
+
+// timeRange adds the terms that select the objects whose field is in [from, to). Zero values leave the
+// range open.
+func (q cseQuery) timeRange(field string, from time.Time, to time.Time) cseQuery {
+	if !from.IsZero() {
+		q = append(q, fmt.Sprintf("%s:>=%s", field, from.UTC().Format(time.RFC3339)))
+	}
+	if !to.IsZero() {
+		q = append(q, fmt.Sprintf("%s:<%s", field, to.UTC().Format(time.RFC3339)))
+	}
+	return q
+}
+
+// params returns the parameters of a search with the query, if it isn't empty.
+func (q cseQuery) params() url.Values {
+	params := url.Values{}
+	if len(q) > 0 {
+		params.Set("q", strings.Join(q, " "))
+	}
+	return params
+}
+
+// cseTimestampInRange returns whether a timestamp of an insight or signal is in [from, to).
+func cseTimestampInRange(timestamp string, from time.Time, to time.Time) bool {
+	if from.IsZero() && to.IsZero() {
+		return true
+	}
+	t, err := time.Parse(time.RFC3339Nano, timestamp)
+	if err != nil {
+		t, err = time.Parse("2006-01-02T15:04:05", timestamp)
+		if err != nil {
+			log.Printf("[WARN] Unexpected timestamp: %s", timestamp)
+			return true
+		}
+	}
+	return (from.IsZero() || !t.Before(from)) && (to.IsZero() || t.Before(to))
+}
@@ -0,0 +1,89 @@
+package sumologic
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CSESignalSearch selects the signals returned by SearchCSESignals.
+type CSESignalSearch struct {
+	Query string
+	// From and To select the signals whose timestamp is in [From, To). Zero values leave the range open.
+	From time.Time
+	To   time.Time
+	// RuleIDs selects the signals generated by any of the rules.
+	RuleIDs     []string
+	EntityType  string
+	EntityValue string
+	MinSeverity int
+	// Tags selects the signals that have all of them.
+	Tags []string
+}
+
+// query returns the query selecting the signals, which are also matched by matches, since the API may
+// compare the entity values with a different case.
+func (f CSESignalSearch) query() cseQuery {
+	query := newCSEQuery(f.Query).timeRange("timestamp", f.From, f.To).anyOf("ruleId", f.RuleIDs)
+	if f.EntityType != "" {
+		query = query.field("entity.entityType", f.EntityType)
+	}
+	if f.EntityValue != "" {
+		query = query.field("entity.value", f.EntityValue)
+	}
+	if f.MinSeverity > 0 {
+		query = append(query, fmt.Sprintf("severity:>=%d", f.MinSeverity))
+	}
+	for _, tag := range f.Tags {
+		query = query.field("tags", tag)
+	}
+	return query
+}
+
+func (f CSESignalSearch) matches(signal CSESignal) bool {
+	if !cseTimestampInRange(signal.Timestamp, f.From, f.To) {
+		return false
+	}
+	if len(f.RuleIDs) > 0 && !contains(f.RuleIDs, signal.RuleID) {
+		return false
+	}
+	if f.EntityType != "" && f.EntityType != signal.Entity.EntityType {
+		return false
+	}
+	if f.EntityValue != "" && !strings.EqualFold(f.EntityValue, signal.Entity.Value) {
+		return false
+	}
+	if signal.Severity < f.MinSeverity {
+		return false
+	}
+	for _, tag := range f.Tags {
+		if !contains(signal.Tags, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// SearchCSESignals lists the signals that match the search.
+func (s *Client) SearchCSESignals(search CSESignalSearch) ([]CSESignal, error) {
+	return searchCSEObjects(s, "sec/v1/signals", "signals", search.query().params(), search.matches)
+}
+
+type CSESignal struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	RuleID      string          `json:"ruleId"`
+	Severity    int             `json:"severity"`
+	Stage       string          `json:"stage"`
+	Timestamp   string          `json:"timestamp"`
+	Entity      CSESignalEntity `json:"entity"`
+	Tags        []string        `json:"tags"`
+	Prototype   bool            `json:"isPrototype"`
+}
+
+type CSESignalEntity struct {
+	ID         string `json:"id"`
+	EntityType string `json:"entityType"`
+	Value      string `json:"value"`
+}
@@ -0,0 +1,126 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// cseThreatIntelIndicatorTypes are the types of threat intel indicators, the STIX object paths of
+// their values.
+var cseThreatIntelIndicatorTypes = []string{
+	"ipv4-addr:value",
+	"ipv6-addr:value",
+	"domain-name:value",
+	"url:value",
+	"email-addr:value",
+	"file:hashes.MD5",
+	"file:hashes.SHA-1",
+	"file:hashes.SHA-256",
+}
+
+func (s *Client) SendGetCSEThreatIntelIndicatorsRequest(sourceId string, offset int) (*CSEThreatIntelIndicatorsGet, error) {
+	data, err := s.Get(fmt.Sprintf("sec/v1/threat-intel-indicators?sourceIds=%s&limit=%d&offset=%d", sourceId, limit, offset))
+	if err != nil {
+		return nil, err
+	}
+
+	if data == nil {
+		return nil, nil
+	}
+
+	var response CSEThreatIntelIndicatorsResponse
+	err = json.Unmarshal(data, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.CSEThreatIntelIndicatorsGet, nil
+}
+
+// GetCSEThreatIntelIndicatorsInSource returns all the indicators of a threat intel source. The indicators
+// are searched by source, so a source that doesn't exist has no indicators.
+func (s *Client) GetCSEThreatIntelIndicatorsInSource(sourceId string) ([]CSEThreatIntelIndicator, error) {
+	response, err := s.SendGetCSEThreatIntelIndicatorsRequest(sourceId, 0)
+	if err != nil {
+		return nil, err
+	}
+	if response == nil {
+		return []CSEThreatIntelIndicator{}, nil
+	}
+
+	indicators := response.Objects
+	for offset := limit; offset < response.Total; offset += limit {
+		nextPageResponse, err := s.SendGetCSEThreatIntelIndicatorsRequest(sourceId, offset)
+		if err != nil {
+			return nil, err
+		}
+		if nextPageResponse == nil || len(nextPageResponse.Objects) == 0 {
+			break
+		}
+		indicators = append(indicators, nextPageResponse.Objects...)
+	}
+
+	if indicators == nil {
+		indicators = []CSEThreatIntelIndicator{}
+	}
+	return indicators, nil
+}
+
+func (s *Client) SendCreateCSEThreatIntelIndicatorsRequest(indicators []CSEThreatIntelIndicator, sourceId string) error {
+	request := CSEThreatIntelIndicatorRequestPost{
+		Indicators: indicators,
+	}
+
+	_, err := s.Post(fmt.Sprintf("sec/v1/threat-intel-sources/%s/items", sourceId), request)
+
+	return err
+}
+
+func (s *Client) CreateCSEThreatIntelIndicators(indicators []CSEThreatIntelIndicator, sourceId string) error {
+	return sendCSEBatches(indicators, func(batch []CSEThreatIntelIndicator) error {
+		return s.SendCreateCSEThreatIntelIndicatorsRequest(batch, sourceId)
+	})
+}
+
+func (s *Client) SendDeleteCSEThreatIntelIndicatorsRequest(ids []string, sourceId string) error {
+	request := CSEThreatIntelIndicatorRequestDelete{
+		IDs: ids,
+	}
+
+	_, err := s.Post(fmt.Sprintf("sec/v1/threat-intel-sources/%s/items/bulk-delete", sourceId), request)
+
+	return err
+}
+
+func (s *Client) DeleteCSEThreatIntelIndicators(ids []string, sourceId string) error {
+	return sendCSEBatches(ids, func(batch []string) error {
+		return s.SendDeleteCSEThreatIntelIndicatorsRequest(batch, sourceId)
+	})
+}
+
+type CSEThreatIntelIndicatorRequestPost struct {
+	Indicators []CSEThreatIntelIndicator `json:"items"`
+}
+
+type CSEThreatIntelIndicatorRequestDelete struct {
+	IDs []string `json:"ids"`
+}
+
+type CSEThreatIntelIndicatorsResponse struct {
+	CSEThreatIntelIndicatorsGet CSEThreatIntelIndicatorsGet `json:"data"`
+}
+
+type CSEThreatIntelIndicatorsGet struct {
+	Objects []CSEThreatIntelIndicator `json:"objects"`
+	Total   int                       `json:"total"`
+}
+
+type CSEThreatIntelIndicator struct {
+	ID          string `json:"id,omitempty"`
+	Active      bool   `json:"active,omitempty"`
+	Value       string `json:"value"`
+	Type        string `json:"type"`
+	Confidence  int    `json:"confidence,omitempty"`
+	Expiration  string `json:"expiration,omitempty"`
+	Description string `json:"description,omitempty"`
+}
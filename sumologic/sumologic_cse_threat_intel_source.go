@@ -0,0 +1,89 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func (s *Client) GetCSEThreatIntelSource(id string) (*CSEThreatIntelSourceGet, error) {
+	data, err := s.Get(fmt.Sprintf("sec/v1/threat-intel-sources/%s", id))
+	if err != nil {
+		return nil, err
+	}
+
+	if data == nil {
+		return nil, nil
+	}
+
+	var response CSEThreatIntelSourceResponse
+	err = json.Unmarshal(data, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.CSEThreatIntelSourceGet, nil
+}
+
+func (s *Client) DeleteCSEThreatIntelSource(id string) error {
+	_, err := s.Delete(fmt.Sprintf("sec/v1/threat-intel-sources/%s", id))
+
+	return err
+}
+
+func (s *Client) CreateCSEThreatIntelSource(cseThreatIntelSourcePost CSEThreatIntelSourcePost) (string, error) {
+
+	request := CSEThreatIntelSourceRequestPost{
+		CSEThreatIntelSourcePost: cseThreatIntelSourcePost,
+	}
+
+	var response CSEThreatIntelSourceResponse
+
+	responseBody, err := s.Post("sec/v1/threat-intel-sources/custom", request)
+	if err != nil {
+		return "", err
+	}
+
+	err = json.Unmarshal(responseBody, &response)
+
+	if err != nil {
+		return "", err
+	}
+
+	return response.CSEThreatIntelSourceGet.ID, nil
+}
+
+func (s *Client) UpdateCSEThreatIntelSource(id string, cseThreatIntelSourcePost CSEThreatIntelSourcePost) error {
+	url := fmt.Sprintf("sec/v1/threat-intel-sources/%s/custom", id)
+
+	request := CSEThreatIntelSourceRequestPost{
+		CSEThreatIntelSourcePost: cseThreatIntelSourcePost,
+	}
+
+	_, err := s.Put(url, request)
+
+	return err
+}
+
+type CSEThreatIntelSourceRequestPost struct {
+	CSEThreatIntelSourcePost CSEThreatIntelSourcePost `json:"fields"`
+}
+
+type CSEThreatIntelSourceResponse struct {
+	CSEThreatIntelSourceGet CSEThreatIntelSourceGet `json:"data"`
+}
+
+type CSEThreatIntelSourcePost struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type CSEThreatIntelSourceGet struct {
+	ID            string `json:"id,omitempty"`
+	Name          string `json:"name,omitempty"`
+	Description   string `json:"description,omitempty"`
+	SourceType    string `json:"sourceType,omitempty"`
+	Created       string `json:"created,omitempty"`
+	CreatedBy     string `json:"createdBy,omitempty"`
+	LastUpdated   string `json:"lastUpdated,omitempty"`
+	LastUpdatedBy string `json:"lastUpdatedBy,omitempty"`
+}
@@ -125,6 +125,37 @@ type ServiceMapPanel struct {
 	Environment        string `json:"environment,omitempty"`
 }
 
+// QueryPanel is a honeycomb, map or metrics explorer panel.
+type QueryPanel struct {
+	Id                                     string `json:"id,omitempty"`
+	Key                                    string `json:"key"`
+	Title                                  string `json:"title"`
+	VisualSettings                         string `json:"visualSettings"`
+	KeepVisualSettingsConsistentWithParent bool   `json:"keepVisualSettingsConsistentWithParent"`
+	PanelType                              string `json:"panelType"`
+	// Query panel related properties
+	Queries     []SearchPanelQuery `json:"queries"`
+	Description string             `json:"description"`
+	TimeRange   interface{}        `json:"timeRange"`
+}
+
+type LinksPanel struct {
+	Id                                     string `json:"id,omitempty"`
+	Key                                    string `json:"key"`
+	Title                                  string `json:"title"`
+	VisualSettings                         string `json:"visualSettings"`
+	KeepVisualSettingsConsistentWithParent bool   `json:"keepVisualSettingsConsistentWithParent"`
+	PanelType                              string `json:"panelType"`
+	// Links panel related properties
+	Links []PanelLink `json:"links"`
+}
+
+type PanelLink struct {
+	Title       string `json:"title"`
+	Url         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
 type SearchPanelQuery struct {
 	QueryString            string            `json:"queryString"`
 	QueryType              string            `json:"queryType"`
@@ -195,6 +226,12 @@ type CsvVariableSourceDefinition struct {
 	Values             string `json:"values"`
 }
 
+type LookupTableVariableSourceDefinition struct {
+	VariableSourceType string `json:"variableSourceType"`
+	LookupTableId      string `json:"lookupTableId"`
+	Field              string `json:"field"`
+}
+
 type LogQueryVariableSourceDefinition struct {
 	VariableSourceType string `json:"variableSourceType"`
 	Query              string `json:"query"`
@@ -1,6 +1,7 @@
 package sumologic
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -27,7 +28,7 @@ func (s *Client) GetFolder(id string) (*Folder, error) {
 	return &folder, nil
 }
 
-func (s *Client) DeleteFolder(id string, timeout time.Duration) error {
+func (s *Client) DeleteFolder(ctx context.Context, id string, timeout time.Duration) error {
 	url := fmt.Sprintf("v2/content/%s/delete", id)
 	rawJID, err := s.Delete(url)
 	if err != nil {
@@ -42,7 +43,7 @@ func (s *Client) DeleteFolder(id string, timeout time.Duration) error {
 	log.Printf("[DEBUG] Delete folder job id: %s", jid.ID)
 
 	url = fmt.Sprintf("v2/content/%s/delete/%s/status", id, jid.ID)
-	_, err = waitForJob(url, timeout, s)
+	_, err = s.WaitForAsyncJob(ctx, AsyncJob{ID: jid.ID, StatusURL: url}, timeout)
 	return err
 }
 
@@ -86,36 +87,42 @@ func (s *Client) getPersonalFolder() (*Folder, error) {
 	return &personalFolder, nil
 }
 
-func (s *Client) getAdminRecommendedFolder(timeout time.Duration) (*Folder, error) {
-	url := "v2/content/folders/adminRecommended"
-	rawJID, err := s.Get(url)
+func (s *Client) getAdminRecommendedFolder(ctx context.Context, timeout time.Duration) (*Folder, error) {
+	rawContent, err := s.getAdminRecommendedFolderResult(ctx, timeout)
 	if err != nil {
 		return nil, err
 	}
 
-	var jid JobId
-	err = json.Unmarshal(rawJID, &jid)
+	var adminRecommendedFolder Folder
+	err = json.Unmarshal(rawContent, &adminRecommendedFolder)
 	if err != nil {
 		return nil, err
 	}
-	log.Printf("[DEBUG] Admin Recommended folder job id: %s", jid.ID)
+	return &adminRecommendedFolder, nil
+}
 
-	url = fmt.Sprintf("v2/content/folders/adminRecommended/%s/status", jid.ID)
-	_, err = waitForJob(url, timeout, s)
+// getAdminRecommendedFolderResult runs the async job that reads the Admin Recommended folder and
+// returns its raw result.
+func (s *Client) getAdminRecommendedFolderResult(ctx context.Context, timeout time.Duration) ([]byte, error) {
+	url := "v2/content/folders/adminRecommended"
+	rawJID, err := s.Get(url)
 	if err != nil {
 		return nil, err
 	}
 
-	url = fmt.Sprintf("v2/content/folders/adminRecommended/%s/result", jid.ID)
-	rawContent, err := s.Get(url)
+	var jid JobId
+	err = json.Unmarshal(rawJID, &jid)
 	if err != nil {
 		return nil, err
 	}
+	log.Printf("[DEBUG] Admin Recommended folder job id: %s", jid.ID)
 
-	var adminRecommendedFolder Folder
-	err = json.Unmarshal(rawContent, &adminRecommendedFolder)
+	url = fmt.Sprintf("v2/content/folders/adminRecommended/%s/status", jid.ID)
+	_, err = s.WaitForAsyncJob(ctx, AsyncJob{ID: jid.ID, StatusURL: url}, timeout)
 	if err != nil {
 		return nil, err
 	}
-	return &adminRecommendedFolder, nil
+
+	url = fmt.Sprintf("v2/content/folders/adminRecommended/%s/result", jid.ID)
+	return s.Get(url)
 }
@@ -181,6 +181,9 @@ type TriggerCondition struct {
 	AnomalyDetectorType string     `json:"anomalyDetectorType,omitempty"`
 	Sensitivity         float64    `json:"sensitivity,omitempty"`
 	MinAnomalyCount     int        `json:"minAnomalyCount,omitempty"`
+	Operator            string     `json:"operator,omitempty"`
+	MonitorIds          []string   `json:"monitorIds,omitempty"`
+	MonitorStates       []string   `json:"monitorStates,omitempty"`
 }
 
 type MonitorNotification struct {
@@ -3,7 +3,6 @@ package sumologic
 import (
 	"encoding/json"
 	"fmt"
-	"net/url"
 )
 
 func (s *Client) GetPermissions(id string) (*PermissionsResponse, error) {
@@ -39,38 +38,42 @@ func (s *Client) DeletePermissions(contentPermissionsRequest PermissionsRequest,
 }
 
 func (s *Client) GetContentPath(id string) (string, error) {
-	url := fmt.Sprintf("v2/content/%s/path", id)
-	data, err := s.Get(url)
+	path, err := s.getContentPath(id)
 	if err != nil {
 		return "", err
 	}
-	if data == nil {
+	if path == "" {
 		return "", fmt.Errorf("Cannot find path of content='%s'", id)
 	}
-	rsp := make(map[string]interface{})
-	err = json.Unmarshal(data, &rsp)
+	return path, nil
+}
+
+// getContentPath returns the path of the content with the given id, or "" if it does not exist.
+func (s *Client) getContentPath(id string) (string, error) {
+	url := fmt.Sprintf("v2/content/%s/path", id)
+	data, err := s.Get(url)
+	if err != nil || data == nil {
+		return "", err
+	}
+	var contentPath struct {
+		Path string `json:"path"`
+	}
+	err = json.Unmarshal(data, &contentPath)
 	if err != nil {
 		return "", err
 	}
-	return rsp["path"].(string), nil
+	return contentPath.Path, nil
 }
 
 func (s *Client) GetCreatorId(path string) (string, error) {
-	url := fmt.Sprintf("v2/content/path?path=%s", url.QueryEscape(path))
-	data, err := s.Get(url)
+	content, err := s.GetContentByPath(path)
 	if err != nil {
 		return "", err
 	}
-	if data == nil {
+	if content == nil {
 		return "", fmt.Errorf("Cannot find content by path='%s'", path)
 	}
-
-	rsp := make(map[string]interface{})
-	err = json.Unmarshal(data, &rsp)
-	if err != nil {
-		return "", err
-	}
-	return rsp["createdBy"].(string), nil
+	return content.CreatedBy, nil
 }
 
 type PermissionsResponse struct {
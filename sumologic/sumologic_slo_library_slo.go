@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net/url"
+	"strings"
 )
 
 // ---------- ENDPOINTS ----------
@@ -65,6 +67,65 @@ func (s *Client) SLORead(id string) (*SLOLibrarySLO, error) {
 	return &sloRead, nil
 }
 
+func (s *Client) GetSLOByPath(path string) (*SLOLibrarySLO, error) {
+	urlWithParams := fmt.Sprintf(SLOBaseApiUrl+"/path?path=%s", url.QueryEscape(path))
+
+	data, err := s.Get(urlWithParams)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	var slo SLOLibrarySLO
+	if err := json.Unmarshal(data, &slo); err != nil {
+		return nil, err
+	}
+	return &slo, nil
+}
+
+func (s *Client) GetSLOPath(id string) (string, error) {
+	urlWithParams := fmt.Sprintf(SLOBaseApiUrl+"/%s/path", id)
+
+	data, err := s.Get(urlWithParams)
+	if err != nil {
+		return "", err
+	}
+	if data == nil {
+		return "", nil
+	}
+
+	var sloPath SLOLibraryPath
+	if err := json.Unmarshal(data, &sloPath); err != nil {
+		return "", err
+	}
+	return sloPath.Path, nil
+}
+
+func (s *Client) GetSLOReport(ids []string, complianceWindow string) ([]SLOReport, error) {
+	params := url.Values{}
+	params.Add("ids", strings.Join(ids, ","))
+	if complianceWindow != "" {
+		params.Add("complianceWindow", complianceWindow)
+	}
+	urlWithParams := SLOBaseApiUrl + "/sli?" + params.Encode()
+
+	data, err := s.Get(urlWithParams)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	var response SLOReportResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, err
+	}
+	return response.Data, nil
+}
+
 func (s *Client) DeleteSLO(id string) error {
 	urlWithoutParams := SLOBaseApiUrl + "/%s"
 	paramString := ""
@@ -178,6 +239,24 @@ type SLIQuery struct {
 	Field       string `json:"field,omitempty"`
 }
 
+type SLOLibraryPath struct {
+	Path string `json:"path"`
+}
+
+type SLOReportResponse struct {
+	Data []SLOReport `json:"data"`
+}
+
+type SLOReport struct {
+	SloID                string  `json:"sloId"`
+	Name                 string  `json:"name"`
+	Status               string  `json:"status"` // string^(Computed|InProgress|Failed)$
+	ComplianceWindow     string  `json:"complianceWindow"`
+	SLI                  float64 `json:"sli"`                  // [0..100]
+	ErrorBudgetRemaining float64 `json:"errorBudgetRemaining"` // percentage of the error budget left, negative once exhausted
+	BurnRate             float64 `json:"burnRate"`
+}
+
 // SloBurnRateCondition struct for SloBurnRateCondition
 type SloBurnRateCondition struct {
 	TriggerCondition
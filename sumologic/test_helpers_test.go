@@ -0,0 +1,57 @@
+package sumologic
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// mockRoutingHttpClient responds to requests with the body registered for the path of the request
+// URL, relative to the API base URL, and with 404 for any other path. It records the requests it
+// receives, along with their q parameter and their bodies by method and path.
+type mockRoutingHttpClient struct {
+	bodies        map[string]string
+	requests      []string
+	methods       []string
+	queries       []string
+	requestBodies map[string]string
+}
+
+func (c *mockRoutingHttpClient) Do(req *http.Request) (*http.Response, error) {
+	path := strings.TrimPrefix(req.URL.Path, "/api/")
+	c.requests = append(c.requests, path)
+	c.methods = append(c.methods, req.Method+" "+path)
+	c.queries = append(c.queries, req.URL.Query().Get("q"))
+	if req.Body != nil {
+		body, _ := ioutil.ReadAll(req.Body)
+		if c.requestBodies == nil {
+			c.requestBodies = make(map[string]string)
+		}
+		c.requestBodies[req.Method+" "+path] = string(body)
+	}
+
+	body, ok := c.bodies[path]
+	status := http.StatusOK
+	if !ok {
+		status = http.StatusNotFound
+	}
+	return &http.Response{
+		Status:     http.StatusText(status),
+		StatusCode: status,
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+	}, nil
+}
+
+func newRoutingTestClient(bodies map[string]string) (*Client, *mockRoutingHttpClient) {
+	httpClient := &mockRoutingHttpClient{bodies: bodies}
+	client := Client{
+		AccessID:    "abcd",
+		AccessKey:   "ef12",
+		Environment: "us2",
+		httpClient:  httpClient,
+	}
+	client.BaseURL, _ = url.Parse(endpoints[client.Environment])
+	return &client, httpClient
+}
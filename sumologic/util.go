@@ -1,16 +1,13 @@
 package sumologic
 
 import (
-	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
 	"strconv"
 	"strings"
-	"time"
 	"unicode"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -438,43 +435,3 @@ func removeEmptyValues(object interface{}) {
 		}
 	}
 }
-
-func waitForJob(url string, timeout time.Duration, s *Client) (*Status, error) {
-	conf := &resource.StateChangeConf{
-		Pending: []string{
-			"InProgress",
-		},
-		Target: []string{
-			"Success",
-		},
-		Refresh: func() (interface{}, string, error) {
-			var status Status
-			b, err := s.Get(url)
-			if err != nil {
-				return nil, "", err
-			}
-
-			err = json.Unmarshal(b, &status)
-			if err != nil {
-				return nil, "", err
-			}
-
-			if status.Status == "Failed" {
-				return status, status.Status, fmt.Errorf("async job failed - %s", status.Error)
-			}
-
-			return status, status.Status, nil
-		},
-		Timeout:    timeout,
-		Delay:      1 * time.Second,
-		MinTimeout: 1 * time.Second,
-	}
-
-	result, err := conf.WaitForState()
-	log.Printf("[DEBUG] Done waiting for job; err: %s, result: %v", err, result)
-	if status, ok := result.(Status); ok {
-		return &status, err
-	} else {
-		return nil, err
-	}
-}